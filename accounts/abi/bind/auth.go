@@ -30,6 +30,7 @@ import (
 	"github.com/electroneum/electroneum-sc/core/types"
 	"github.com/electroneum/electroneum-sc/crypto"
 	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/params"
 )
 
 // ErrNoChainID is returned whenever the user failed to specify a chain id.
@@ -163,6 +164,38 @@ func NewKeyedTransactorWithChainID(key *ecdsa.PrivateKey, chainID *big.Int) (*Tr
 	}, nil
 }
 
+// NewKeyedPriorityTransactor is a utility method to easily create a priority
+// transaction signer from the private keys of the sender and of the priority
+// transactor. The signers are picked from the chain config by the number of the
+// block the transaction is meant for, as the priority signature scheme changes
+// with the future fork. Transactors holding a gas price waiver need to set the
+// GasPriceWaiver flag on the returned options.
+func NewKeyedPriorityTransactor(key, priorityKey *ecdsa.PrivateKey, config *params.ChainConfig) (*TransactOpts, error) {
+	if config == nil || config.ChainID == nil {
+		return nil, ErrNoChainID
+	}
+	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
+	keyPub := common.BytesToPublicKey(crypto.FromECDSAPub(&priorityKey.PublicKey))
+	signer := types.LatestSigner(config)
+	return &TransactOpts{
+		From: keyAddr,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != keyAddr {
+				return nil, ErrNotAuthorized
+			}
+			return types.SignTx(tx, signer, key)
+		},
+		PriorityKey: keyPub,
+		PrioritySigner: func(pubkey common.PublicKey, number *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+			if pubkey != keyPub {
+				return nil, ErrNotAuthorized
+			}
+			return types.AddPrioritySignature(tx, types.MakeSigner(config, number), priorityKey)
+		},
+		Context: context.Background(),
+	}, nil
+}
+
 // NewClefTransactor is a utility method to easily create a transaction signer
 // with a clef backend.
 func NewClefTransactor(clef *external.ExternalSigner, account accounts.Account) *TransactOpts {
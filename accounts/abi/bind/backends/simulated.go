@@ -31,6 +31,7 @@ import (
 	"github.com/electroneum/electroneum-sc/common/hexutil"
 	"github.com/electroneum/electroneum-sc/common/math"
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/bloombits"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
@@ -54,6 +55,10 @@ var (
 	errTransactionDoesNotExist = errors.New("transaction does not exist")
 )
 
+// priorityTransactorsAddress is where the contract serving the priority
+// transactors of a simulated backend is allocated, if any.
+var priorityTransactorsAddress = common.HexToAddress("0x00000000000000000000000000000000000000ee")
+
 // SimulatedBackend implements bind.ContractBackend, simulating a blockchain in
 // the background. Its main purpose is to allow for easy testing of contract bindings.
 // Simulated backend implements the following interfaces:
@@ -76,7 +81,39 @@ type SimulatedBackend struct {
 // and uses a simulated blockchain for testing purposes.
 // A simulated backend always uses chainID 1337.
 func NewSimulatedBackendWithDatabase(database ethdb.Database, alloc core.GenesisAlloc, gasLimit uint64) *SimulatedBackend {
-	genesis := core.Genesis{Config: params.AllEthashProtocolChanges, GasLimit: gasLimit, Alloc: alloc}
+	return newSimulatedBackend(database, params.AllEthashProtocolChanges, alloc, gasLimit)
+}
+
+// NewSimulatedBackend creates a new binding backend using a simulated blockchain
+// for testing purposes.
+// A simulated backend always uses chainID 1337.
+func NewSimulatedBackend(alloc core.GenesisAlloc, gasLimit uint64) *SimulatedBackend {
+	return NewSimulatedBackendWithDatabase(rawdb.NewMemoryDatabase(), alloc, gasLimit)
+}
+
+// NewSimulatedBackendWithPriorityTransactors creates a new binding backend using
+// a simulated blockchain for testing purposes, which accepts priority transactions
+// from the given transactors. The transactors are served by a stub contract in
+// the genesis block, so they can't be changed afterwards.
+// A simulated backend always uses chainID 1337.
+func NewSimulatedBackendWithPriorityTransactors(alloc core.GenesisAlloc, gasLimit uint64, transactors common.PriorityTransactorMap) *SimulatedBackend {
+	config := *params.AllEthashProtocolChanges
+	config.PriorityTransactorsContractAddress = priorityTransactorsAddress
+
+	code, err := prioritytransactors.StubCode(transactors)
+	if err != nil {
+		panic(err)
+	}
+	genesisAlloc := make(core.GenesisAlloc, len(alloc)+1)
+	for addr, account := range alloc {
+		genesisAlloc[addr] = account
+	}
+	genesisAlloc[priorityTransactorsAddress] = core.GenesisAccount{Code: code, Balance: new(big.Int)}
+	return newSimulatedBackend(rawdb.NewMemoryDatabase(), &config, genesisAlloc, gasLimit)
+}
+
+func newSimulatedBackend(database ethdb.Database, config *params.ChainConfig, alloc core.GenesisAlloc, gasLimit uint64) *SimulatedBackend {
+	genesis := core.Genesis{Config: config, GasLimit: gasLimit, Alloc: alloc}
 	genesis.MustCommit(database)
 	blockchain, _ := core.NewBlockChain(database, nil, genesis.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
 
@@ -90,13 +127,6 @@ func NewSimulatedBackendWithDatabase(database ethdb.Database, alloc core.Genesis
 	return backend
 }
 
-// NewSimulatedBackend creates a new binding backend using a simulated blockchain
-// for testing purposes.
-// A simulated backend always uses chainID 1337.
-func NewSimulatedBackend(alloc core.GenesisAlloc, gasLimit uint64) *SimulatedBackend {
-	return NewSimulatedBackendWithDatabase(rawdb.NewMemoryDatabase(), alloc, gasLimit)
-}
-
 // Close terminates the underlying blockchain's update loop.
 func (b *SimulatedBackend) Close() error {
 	b.blockchain.Stop()
@@ -628,6 +658,8 @@ func (b *SimulatedBackend) callContract(ctx context.Context, call electroneum.Ca
 	// Set infinite balance to the fake caller account.
 	from := stateDB.GetOrNewStateObject(call.From)
 	from.SetBalance(math.MaxBig256)
+	// Load the priority transactors, as blocks being processed do.
+	stateDB.SetPriorityTransactors(b.blockchain.GetPriorityTransactorsForState(block.Header(), stateDB))
 	// Execute the call.
 	msg := callMsg{call}
 
@@ -661,6 +693,11 @@ func (b *SimulatedBackend) SendTransaction(ctx context.Context, tx *types.Transa
 	if tx.Nonce() != nonce {
 		return fmt.Errorf("invalid transaction nonce: got %d, want %d", tx.Nonce(), nonce)
 	}
+	if tx.Type() == types.PriorityTxType {
+		if err := b.validatePriorityTx(tx); err != nil {
+			return fmt.Errorf("invalid transaction: %v", err)
+		}
+	}
 	// Include tx in chain
 	blocks, _ := core.GenerateChain(b.config, block, ethash.NewFaker(), b.database, 1, func(number int, block *core.BlockGen) {
 		for _, tx := range b.pendingBlock.Transactions() {
@@ -675,6 +712,33 @@ func (b *SimulatedBackend) SendTransaction(ctx context.Context, tx *types.Transa
 	return nil
 }
 
+// validatePriorityTx checks a priority transaction against the transactors of
+// the pending state, rejecting it instead of failing the pending block.
+func (b *SimulatedBackend) validatePriorityTx(tx *types.Transaction) error {
+	signer := types.MakeSigner(b.config, b.pendingBlock.Number())
+	transactors := b.blockchain.GetPriorityTransactorsForState(b.pendingBlock.Header(), b.pendingState.Copy())
+	pubkey, transactor, ok, err := core.ResolvePrioritySender(b.config, b.pendingBlock.Number(), signer, tx, func(pubkey common.PublicKey) (common.PriorityTransactor, bool) {
+		transactor, ok := transactors[pubkey]
+		return transactor, ok
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("priority key %x not permitted", pubkey)
+	}
+	if transactor.IsGasPriceWaiver {
+		if tx.GasFeeCap().Sign() != 0 || tx.GasTipCap().Sign() != 0 {
+			return errors.New("gas price waiver with non-zero fee fields")
+		}
+		return nil
+	}
+	if tx.GasFeeCap().Cmp(b.pendingBlock.BaseFee()) < 0 {
+		return fmt.Errorf("max fee per gas %v lower than base fee %v", tx.GasFeeCap(), b.pendingBlock.BaseFee())
+	}
+	return nil
+}
+
 // FilterLogs executes a log filter operation, blocking during execution and
 // returning all the results in one batch.
 //
@@ -809,6 +873,7 @@ func (m callMsg) GasPrice() *big.Int               { return m.CallMsg.GasPrice }
 func (m callMsg) GasFeeCap() *big.Int              { return m.CallMsg.GasFeeCap }
 func (m callMsg) GasTipCap() *big.Int              { return m.CallMsg.GasTipCap }
 func (m callMsg) PrioritySender() common.PublicKey { return m.CallMsg.PrioritySender }
+func (m callMsg) Sponsor() *common.Address         { return nil }
 func (m callMsg) Gas() uint64                      { return m.CallMsg.Gas }
 func (m callMsg) Value() *big.Int                  { return m.CallMsg.Value }
 func (m callMsg) Data() []byte                     { return m.CallMsg.Data }
@@ -1338,3 +1338,72 @@ func TestForkResendTx(t *testing.T) {
 		t.Errorf("TX included in wrong block: %d", h)
 	}
 }
+
+func TestPriorityTransactors(t *testing.T) {
+	var (
+		testAddr      = crypto.PubkeyToAddress(testKey.PublicKey)
+		waiverKey, _  = crypto.GenerateKey()
+		payingKey, _  = crypto.GenerateKey()
+		unknownKey, _ = crypto.GenerateKey()
+		chainID       = big.NewInt(1337)
+	)
+	sim := NewSimulatedBackendWithPriorityTransactors(core.GenesisAlloc{
+		testAddr: {Balance: big.NewInt(10000000000000000)},
+	}, 10000000, common.PriorityTransactorMap{
+		common.BytesToPublicKey(crypto.FromECDSAPub(&waiverKey.PublicKey)): {IsGasPriceWaiver: true, EntityName: "waiver"},
+		common.BytesToPublicKey(crypto.FromECDSAPub(&payingKey.PublicKey)): {IsGasPriceWaiver: false, EntityName: "paying"},
+	})
+	defer sim.Close()
+	bgCtx := context.Background()
+
+	parsed, _ := abi.JSON(strings.NewReader(abiJSON))
+	auth, _ := bind.NewKeyedTransactorWithChainID(testKey, chainID)
+	_, _, contract, err := bind.DeployContract(auth, parsed, common.FromHex(abiBin), sim)
+	if err != nil {
+		t.Fatalf("could not deploy contract: %v", err)
+	}
+	sim.Commit()
+
+	// Waived priority transactions are free
+	waiver, _ := bind.NewKeyedPriorityTransactor(testKey, waiverKey, sim.Blockchain().Config())
+	waiver.GasPriceWaiver = true
+	balance, _ := sim.BalanceAt(bgCtx, testAddr, nil)
+	tx, err := contract.Transact(waiver, "receive", []byte("X"))
+	if err != nil {
+		t.Fatalf("could not send waived priority transaction: %v", err)
+	}
+	if tx.Type() != types.PriorityTxType || tx.GasFeeCap().Sign() != 0 || tx.GasTipCap().Sign() != 0 {
+		t.Errorf("unexpected waived transaction: type %d, fee cap %v, tip cap %v", tx.Type(), tx.GasFeeCap(), tx.GasTipCap())
+	}
+	sim.Commit()
+	if receipt, _ := sim.TransactionReceipt(bgCtx, tx.Hash()); receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("waived priority transaction failed: %v", receipt)
+	}
+	if have, _ := sim.BalanceAt(bgCtx, testAddr, nil); have.Cmp(balance) != 0 {
+		t.Errorf("balance mismatch: have %v, want %v", have, balance)
+	}
+
+	// Transactors without a waiver can't skip the fees
+	paying, _ := bind.NewKeyedPriorityTransactor(testKey, payingKey, sim.Blockchain().Config())
+	paying.GasPriceWaiver = true
+	if _, err := contract.Transact(paying, "receive", []byte("X")); err == nil {
+		t.Errorf("non-waived priority transaction without fees accepted")
+	}
+	paying.GasPriceWaiver = false
+	if tx, err = contract.Transact(paying, "receive", []byte("X")); err != nil {
+		t.Fatalf("could not send paying priority transaction: %v", err)
+	}
+	sim.Commit()
+	if receipt, _ := sim.TransactionReceipt(bgCtx, tx.Hash()); receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("paying priority transaction failed: %v", receipt)
+	}
+	if have, _ := sim.BalanceAt(bgCtx, testAddr, nil); have.Cmp(balance) >= 0 {
+		t.Errorf("paying priority transaction was free: balance %v", have)
+	}
+
+	// Unknown keys are rejected altogether
+	unknown, _ := bind.NewKeyedPriorityTransactor(testKey, unknownKey, sim.Blockchain().Config())
+	if _, err := contract.Transact(unknown, "receive", []byte("X")); err == nil {
+		t.Errorf("priority transaction of unknown key accepted")
+	}
+}
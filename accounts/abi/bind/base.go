@@ -36,6 +36,12 @@ import (
 // sign the transaction before submission.
 type SignerFn func(common.Address, *types.Transaction) (*types.Transaction, error)
 
+// PrioritySignerFn is a signer function callback when a priority transaction
+// requires the signature of a priority key on top of the sender's one. It gets
+// the number of the block the transaction is meant for, as the priority signature
+// scheme depends on it.
+type PrioritySignerFn func(common.PublicKey, *big.Int, *types.Transaction) (*types.Transaction, error)
+
 // CallOpts is the collection of options to fine tune a contract call request.
 type CallOpts struct {
 	Pending     bool            // Whether to operate on the pending state or the last known one
@@ -51,6 +57,10 @@ type TransactOpts struct {
 	Nonce  *big.Int       // Nonce to use for the transaction execution (nil = use pending state)
 	Signer SignerFn       // Method to use for signing the transaction (mandatory)
 
+	PriorityKey    common.PublicKey // Priority key to send the transaction with (zero = regular transaction)
+	PrioritySigner PrioritySignerFn // Method to use for adding the priority signature (mandatory with a priority key)
+	GasPriceWaiver bool             // Whether the priority key is exempted from paying for gas (fee fields forced to zero)
+
 	Value     *big.Int // Funds to transfer along the transaction (nil = 0 = no funds)
 	GasPrice  *big.Int // Gas price to use for the transaction execution (nil = gas price oracle)
 	GasFeeCap *big.Int // Gas fee cap to use for the 1559 transaction execution (nil = gas price oracle)
@@ -286,6 +296,73 @@ func (c *BoundContract) createDynamicTx(opts *TransactOpts, contract *common.Add
 	return types.NewTx(baseTx), nil
 }
 
+func (c *BoundContract) createPriorityTx(opts *TransactOpts, contract *common.Address, input []byte, head *types.Header) (*types.Transaction, error) {
+	if opts.GasPrice != nil {
+		return nil, errors.New("gasPrice specified for a priority transaction")
+	}
+	if head.BaseFee == nil {
+		return nil, errors.New("priority transaction requested but london is not active yet")
+	}
+	// Normalize value
+	value := opts.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	// Senders with a gas price waiver must not pay anything, the others are
+	// priced just like regular dynamic fee transactions
+	gasTipCap, gasFeeCap := opts.GasTipCap, opts.GasFeeCap
+	if opts.GasPriceWaiver {
+		if (gasTipCap != nil && gasTipCap.Sign() != 0) || (gasFeeCap != nil && gasFeeCap.Sign() != 0) {
+			return nil, errors.New("maxFeePerGas or maxPriorityFeePerGas specified for a gas price waiver")
+		}
+		gasTipCap, gasFeeCap = new(big.Int), new(big.Int)
+	} else {
+		if gasTipCap == nil {
+			tip, err := c.transactor.SuggestGasTipCap(ensureContext(opts.Context))
+			if err != nil {
+				return nil, err
+			}
+			gasTipCap = tip
+		}
+		if gasFeeCap == nil {
+			gasFeeCap = new(big.Int).Add(
+				gasTipCap,
+				new(big.Int).Mul(head.BaseFee, big.NewInt(2)),
+			)
+		}
+		if gasFeeCap.Cmp(gasTipCap) < 0 {
+			return nil, fmt.Errorf("maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", gasFeeCap, gasTipCap)
+		}
+		if gasFeeCap.Sign() == 0 {
+			return nil, errors.New("zero maxFeePerGas specified without a gas price waiver")
+		}
+	}
+	// Estimate GasLimit
+	gasLimit := opts.GasLimit
+	if opts.GasLimit == 0 {
+		var err error
+		gasLimit, err = c.estimateGasLimit(opts, contract, input, nil, gasTipCap, gasFeeCap, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// create the transaction
+	nonce, err := c.getNonce(opts)
+	if err != nil {
+		return nil, err
+	}
+	baseTx := &types.PriorityTx{
+		To:        contract,
+		Nonce:     nonce,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Gas:       gasLimit,
+		Value:     value,
+		Data:      input,
+	}
+	return types.NewTx(baseTx), nil
+}
+
 func (c *BoundContract) createLegacyTx(opts *TransactOpts, contract *common.Address, input []byte) (*types.Transaction, error) {
 	if opts.GasFeeCap != nil || opts.GasTipCap != nil {
 		return nil, errors.New("maxFeePerGas or maxPriorityFeePerGas specified but london is not active yet")
@@ -339,13 +416,14 @@ func (c *BoundContract) estimateGasLimit(opts *TransactOpts, contract *common.Ad
 		}
 	}
 	msg := electroneum.CallMsg{
-		From:      opts.From,
-		To:        contract,
-		GasPrice:  gasPrice,
-		GasTipCap: gasTipCap,
-		GasFeeCap: gasFeeCap,
-		Value:     value,
-		Data:      input,
+		From:           opts.From,
+		To:             contract,
+		GasPrice:       gasPrice,
+		GasTipCap:      gasTipCap,
+		GasFeeCap:      gasFeeCap,
+		PrioritySender: opts.PriorityKey,
+		Value:          value,
+		Data:           input,
 	}
 	return c.transactor.EstimateGas(ensureContext(opts.Context), msg)
 }
@@ -366,10 +444,18 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	}
 	// Create the transaction
 	var (
-		rawTx *types.Transaction
-		err   error
+		rawTx  *types.Transaction
+		number *big.Int
+		err    error
 	)
-	if opts.GasPrice != nil {
+	if opts.PriorityKey != (common.PublicKey{}) {
+		head, errHead := c.transactor.HeaderByNumber(ensureContext(opts.Context), nil)
+		if errHead != nil {
+			return nil, errHead
+		}
+		number = new(big.Int).Add(head.Number, common.Big1)
+		rawTx, err = c.createPriorityTx(opts, contract, input, head)
+	} else if opts.GasPrice != nil {
 		rawTx, err = c.createLegacyTx(opts, contract, input)
 	} else {
 		// Only query for basefee if gasPrice not specified
@@ -393,6 +479,14 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if err != nil {
 		return nil, err
 	}
+	if opts.PriorityKey != (common.PublicKey{}) {
+		if opts.PrioritySigner == nil {
+			return nil, errors.New("no priority signer to authorize the transaction with")
+		}
+		if signedTx, err = opts.PrioritySigner(opts.PriorityKey, number, signedTx); err != nil {
+			return nil, err
+		}
+	}
 	if opts.NoSend {
 		return signedTx, nil
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/electroneum/electroneum-sc/common/hexutil"
 	"github.com/electroneum/electroneum-sc/core/types"
 	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
 	"github.com/electroneum/electroneum-sc/rlp"
 	"github.com/stretchr/testify/assert"
 )
@@ -46,7 +47,7 @@ type mockTransactor struct {
 }
 
 func (mt *mockTransactor) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
-	return &types.Header{BaseFee: mt.baseFee}, nil
+	return &types.Header{Number: new(big.Int), BaseFee: mt.baseFee}, nil
 }
 
 func (mt *mockTransactor) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
@@ -329,6 +330,89 @@ func TestTransactGasFee(t *testing.T) {
 	assert.True(mt.suggestGasPriceCalled)
 }
 
+func TestTransactPriority(t *testing.T) {
+	assert := assert.New(t)
+
+	var prioritySigned common.PublicKey
+	mockPrioritySign := func(pubkey common.PublicKey, number *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+		prioritySigned = pubkey
+		return tx, nil
+	}
+	mt := &mockTransactor{baseFee: big.NewInt(100), gasTipCap: big.NewInt(5)}
+	bc := bind.NewBoundContract(common.Address{}, abi.ABI{}, nil, mt, nil)
+	opts := &bind.TransactOpts{Signer: mockSign, PriorityKey: common.PublicKey{4}, PrioritySigner: mockPrioritySign}
+
+	// Without a waiver, fees are priced as dynamic fee transactions
+	tx, err := bc.Transact(opts, "")
+	assert.Nil(err)
+	assert.Equal(uint8(types.PriorityTxType), tx.Type())
+	assert.Equal(big.NewInt(5), tx.GasTipCap())
+	assert.Equal(big.NewInt(205), tx.GasFeeCap())
+	assert.Equal(opts.PriorityKey, prioritySigned)
+
+	opts.GasFeeCap = new(big.Int)
+	opts.GasTipCap = new(big.Int)
+	_, err = bc.Transact(opts, "")
+	assert.NotNil(err)
+
+	// With a waiver, fees are zero and can't be overridden
+	opts.GasPriceWaiver = true
+	mt.suggestGasTipCapCalled = false
+	tx, err = bc.Transact(opts, "")
+	assert.Nil(err)
+	assert.Equal(0, tx.GasTipCap().Sign())
+	assert.Equal(0, tx.GasFeeCap().Sign())
+	assert.False(mt.suggestGasTipCapCalled)
+
+	opts.GasFeeCap = big.NewInt(1)
+	_, err = bc.Transact(opts, "")
+	assert.NotNil(err)
+
+	// Legacy pricing and missing priority signers are rejected
+	opts = &bind.TransactOpts{Signer: mockSign, PriorityKey: common.PublicKey{4}, GasPrice: big.NewInt(1)}
+	_, err = bc.Transact(opts, "")
+	assert.NotNil(err)
+	opts.GasPrice = nil
+	_, err = bc.Transact(opts, "")
+	assert.NotNil(err)
+}
+
+func TestTransactPriorityFutureFork(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	priorityKey, _ := crypto.GenerateKey()
+	priorityPub := common.BytesToPublicKey(crypto.FromECDSAPub(&priorityKey.PublicKey))
+
+	mt := &mockTransactor{baseFee: big.NewInt(100), gasTipCap: big.NewInt(5)}
+	bc := bind.NewBoundContract(common.Address{}, abi.ABI{}, nil, mt, nil)
+
+	// The mocked head is the genesis block, so the transactions are meant for block 1
+	for _, fork := range []int64{1, 2} {
+		config := *params.TestChainConfig
+		config.FutureForkBlock = big.NewInt(fork)
+
+		opts, err := bind.NewKeyedPriorityTransactor(key, priorityKey, &config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts.GasPriceWaiver = true
+		tx, err := bc.Transact(opts, "")
+		if err != nil {
+			t.Fatalf("fork %d: failed to transact: %v", fork, err)
+		}
+		signer := types.MakeSigner(&config, big.NewInt(1))
+		if from, err := types.Sender(signer, tx); err != nil || from != opts.From {
+			t.Errorf("fork %d: sender mismatch: have %v (%v), want %v", fork, from, err, opts.From)
+		}
+		if pub, err := types.PrioritySender(signer, tx); err != nil || pub != priorityPub {
+			t.Errorf("fork %d: priority sender mismatch: have %x (%v), want %x", fork, pub, err, priorityPub)
+		}
+		// After the fork, the priority signature must not verify under the old scheme
+		if pub, err := types.PrioritySender(types.LatestSignerForChainID(config.ChainID), tx); fork == 1 && err == nil && pub == priorityPub {
+			t.Errorf("fork %d: priority signature made with the pre-fork scheme", fork)
+		}
+	}
+}
+
 func unpackAndCheck(t *testing.T, bc *bind.BoundContract, expected map[string]interface{}, mockLog types.Log) {
 	received := make(map[string]interface{})
 	if err := bc.UnpackLogIntoMap(received, "received", mockLog); err != nil {
@@ -151,6 +151,22 @@ type Wallet interface {
 
 	// SignTxWithPassphrase is identical to SignTx, but also takes a password
 	SignTxWithPassphrase(account Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// SignPriorityTx requests the wallet to add the priority signature of the given
+	// account to a priority transaction already signed by its sender. The priority
+	// signature scheme changes with the future fork, so the signer must be the one
+	// of the block the transaction is meant for, as made by types.MakeSigner.
+	//
+	// It looks up the account specified either solely via its address contained within,
+	// or optionally with the aid of any location metadata from the embedded URL field.
+	//
+	// If the wallet requires additional authentication to sign the request, an
+	// AuthNeededError instance will be returned, the same as with SignTx. The user
+	// may retry via SignPriorityTxWithPassphrase, or by other means.
+	SignPriorityTx(account Account, tx *types.Transaction, signer types.Signer) (*types.Transaction, error)
+
+	// SignPriorityTxWithPassphrase is identical to SignPriorityTx, but also takes a password
+	SignPriorityTxWithPassphrase(account Account, passphrase string, tx *types.Transaction, signer types.Signer) (*types.Transaction, error)
 }
 
 // Backend is a "wallet provider" that may contain a batch of accounts they can
@@ -26,6 +26,7 @@ import (
 	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/common/hexutil"
 	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/crypto"
 	"github.com/electroneum/electroneum-sc/event"
 	"github.com/electroneum/electroneum-sc/log"
 	"github.com/electroneum/electroneum-sc/rpc"
@@ -197,6 +198,53 @@ type signTransactionResult struct {
 // by the external signer. For non-legacy transactions, the chain ID of the
 // transaction overrides the chainID parameter.
 func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) { //sort this (clef) out another time
+	args, err := txArgs(account.Address, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	var res signTransactionResult
+	if err := api.client.Call(&res, "account_signTransaction", args); err != nil {
+		return nil, err
+	}
+	return res.Tx, nil
+}
+
+// SignPriorityTx sends the priority transaction to the external signer, which
+// must hold both the key of its sender and the given priority account. The signer
+// is told which priority signature scheme the given signer uses.
+func (api *ExternalSigner) SignPriorityTx(account accounts.Account, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	if tx.Type() != types.PriorityTxType {
+		return nil, types.ErrTxIsNotPriorityType
+	}
+	// The sender can't be derived the usual way, as that also verifies the
+	// priority signature, which is yet to be made
+	v, r, s := tx.RawSignatureValues()
+	if r == nil || r.Sign() == 0 {
+		return nil, types.ErrTxIsNotSenderSigned
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = byte(v.Uint64())
+
+	hash := signer.Hash(tx)
+	pub, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return nil, err
+	}
+	args, err := txArgs(crypto.PubkeyToAddress(*pub), tx, signer.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	var res signTransactionResult
+	if err := api.client.Call(&res, "account_signPriorityTransaction", args, common.NewMixedcaseAddress(account.Address), types.SenderBoundPriority(signer)); err != nil {
+		return nil, err
+	}
+	return res.Tx, nil
+}
+
+// txArgs converts a transaction into the arguments of a signing request.
+func txArgs(from common.Address, tx *types.Transaction, chainID *big.Int) (*apitypes.SendTxArgs, error) {
 	data := hexutil.Bytes(tx.Data())
 	var to *common.MixedcaseAddress
 	if tx.To() != nil {
@@ -209,7 +257,7 @@ func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transactio
 		Value: hexutil.Big(*tx.Value()),
 		Gas:   hexutil.Uint64(tx.Gas()),
 		To:    to,
-		From:  common.NewMixedcaseAddress(account.Address),
+		From:  common.NewMixedcaseAddress(from),
 	}
 	switch tx.Type() {
 	case types.LegacyTxType, types.AccessListTxType:
@@ -237,11 +285,7 @@ func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transactio
 		accessList := tx.AccessList()
 		args.AccessList = &accessList
 	}
-	var res signTransactionResult
-	if err := api.client.Call(&res, "account_signTransaction", args); err != nil {
-		return nil, err
-	}
-	return res.Tx, nil
+	return args, nil
 }
 
 func (api *ExternalSigner) SignTextWithPassphrase(account accounts.Account, passphrase string, text []byte) ([]byte, error) {
@@ -251,6 +295,11 @@ func (api *ExternalSigner) SignTextWithPassphrase(account accounts.Account, pass
 func (api *ExternalSigner) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
+
+func (api *ExternalSigner) SignPriorityTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	return nil, fmt.Errorf("password-operations not supported on external signers")
+}
+
 func (api *ExternalSigner) SignDataWithPassphrase(account accounts.Account, passphrase, mimeType string, data []byte) ([]byte, error) {
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
@@ -313,6 +313,32 @@ func (ks *KeyStore) SignTxWithPassphrase(a accounts.Account, passphrase string,
 	return types.SignTx(tx, signer, key.PrivateKey)
 }
 
+// SignPriorityTx adds the priority signature of the requested account to a
+// priority transaction already signed by its sender.
+func (ks *KeyStore) SignPriorityTx(a accounts.Account, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	// Look up the key to sign with and abort if it cannot be found
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	unlockedKey, found := ks.unlocked[a.Address]
+	if !found {
+		return nil, ErrLocked
+	}
+	return types.AddPrioritySignature(tx, signer, unlockedKey.PrivateKey)
+}
+
+// SignPriorityTxWithPassphrase adds the priority signature to the transaction if
+// the private key matching the given address can be decrypted with the given
+// passphrase.
+func (ks *KeyStore) SignPriorityTxWithPassphrase(a accounts.Account, passphrase string, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	_, key, err := ks.getDecryptedKey(a, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroKey(key.PrivateKey)
+	return types.AddPrioritySignature(tx, signer, key.PrivateKey)
+}
+
 // Unlock unlocks the given account indefinitely.
 func (ks *KeyStore) Unlock(a accounts.Account, passphrase string) error {
 	return ks.TimedUnlock(a, passphrase, 0)
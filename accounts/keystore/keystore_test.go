@@ -17,6 +17,7 @@
 package keystore
 
 import (
+	"math/big"
 	"math/rand"
 	"os"
 	"runtime"
@@ -29,8 +30,10 @@ import (
 
 	"github.com/electroneum/electroneum-sc/accounts"
 	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/types"
 	"github.com/electroneum/electroneum-sc/crypto"
 	"github.com/electroneum/electroneum-sc/event"
+	"github.com/electroneum/electroneum-sc/params"
 )
 
 var testSigData = make([]byte, 32)
@@ -112,6 +115,51 @@ func TestSignWithPassphrase(t *testing.T) {
 	}
 }
 
+func TestSignPriorityTxWithPassphrase(t *testing.T) {
+	_, ks := tmpKeyStore(t, true)
+
+	pass := "passwd"
+	sender, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priority, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainID := big.NewInt(1)
+	tx := types.NewTx(&types.PriorityTx{ChainID: chainID, Gas: 21000, GasFeeCap: new(big.Int), GasTipCap: new(big.Int), Value: new(big.Int)})
+
+	signer := types.LatestSignerForChainID(chainID)
+
+	// The priority signature can only be added once the sender has signed
+	if _, err := ks.SignPriorityTxWithPassphrase(priority, pass, tx, signer); err != types.ErrTxIsNotSenderSigned {
+		t.Fatalf("error mismatch: have %v, want %v", err, types.ErrTxIsNotSenderSigned)
+	}
+	signed, err := ks.SignTxWithPassphrase(sender, pass, tx, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err = ks.SignPriorityTxWithPassphrase(priority, pass, signed, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from, err := types.Sender(signer, signed); err != nil || from != sender.Address {
+		t.Fatalf("sender mismatch: have %v (%v), want %v", from, err, sender.Address)
+	}
+	pub, err := types.PrioritySender(signer, signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := crypto.UnmarshalPubkey(pub.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr := crypto.PubkeyToAddress(*key); addr != priority.Address {
+		t.Fatalf("priority signer mismatch: have %v, want %v", addr, priority.Address)
+	}
+}
+
 func TestTimedUnlock(t *testing.T) {
 	_, ks := tmpKeyStore(t, true)
 
@@ -453,3 +501,49 @@ func tmpKeyStore(t *testing.T, encrypted bool) (string, *KeyStore) {
 	}
 	return d, newKs(d)
 }
+
+// Tests that priority transactions signed after the future fork carry the
+// sender-bound priority signature.
+func TestSignPriorityTxFutureFork(t *testing.T) {
+	_, ks := tmpKeyStore(t, true)
+
+	pass := "passwd"
+	sender, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priority, err := ks.NewAccount(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := *params.TestChainConfig
+	config.FutureForkBlock = big.NewInt(10)
+	signer := types.MakeSigner(&config, big.NewInt(10))
+
+	tx := types.NewTx(&types.PriorityTx{ChainID: config.ChainID, Gas: 21000, GasFeeCap: new(big.Int), GasTipCap: new(big.Int), Value: new(big.Int)})
+	signed, err := ks.SignTxWithPassphrase(sender, pass, tx, config.ChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err = ks.SignPriorityTxWithPassphrase(priority, pass, signed, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := types.PrioritySender(signer, signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := crypto.UnmarshalPubkey(pub.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr := crypto.PubkeyToAddress(*key); addr != priority.Address {
+		t.Fatalf("priority signer mismatch: have %v, want %v", addr, priority.Address)
+	}
+	// The pre-fork scheme must not recover the priority account
+	if pub, err := types.PrioritySender(types.LatestSignerForChainID(config.ChainID), signed); err == nil {
+		if key, err := crypto.UnmarshalPubkey(pub.Bytes()); err == nil && crypto.PubkeyToAddress(*key) == priority.Address {
+			t.Fatal("priority signature recovered with the pre-fork signer")
+		}
+	}
+}
@@ -148,3 +148,25 @@ func (w *keystoreWallet) SignTxWithPassphrase(account accounts.Account, passphra
 	// Account seems valid, request the keystore to sign
 	return w.keystore.SignTxWithPassphrase(account, passphrase, tx, chainID)
 }
+
+// SignPriorityTx implements accounts.Wallet, attempting to add the priority
+// signature of the given account to the given transaction.
+func (w *keystoreWallet) SignPriorityTx(account accounts.Account, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	// Make sure the requested account is contained within
+	if !w.Contains(account) {
+		return nil, accounts.ErrUnknownAccount
+	}
+	// Account seems valid, request the keystore to sign
+	return w.keystore.SignPriorityTx(account, tx, signer)
+}
+
+// SignPriorityTxWithPassphrase implements accounts.Wallet, attempting to add the
+// priority signature of the given account using passphrase as extra authentication.
+func (w *keystoreWallet) SignPriorityTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	// Make sure the requested account is contained within
+	if !w.Contains(account) {
+		return nil, accounts.ErrUnknownAccount
+	}
+	// Account seems valid, request the keystore to sign
+	return w.keystore.SignPriorityTxWithPassphrase(account, passphrase, tx, signer)
+}
@@ -637,3 +637,16 @@ func (w *wallet) SignTextWithPassphrase(account accounts.Account, passphrase str
 func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	return w.SignTx(account, tx, chainID)
 }
+
+// SignPriorityTx implements accounts.Wallet, however priority signatures are not
+// supported by hardware wallets, so this method will always return an error.
+func (w *wallet) SignPriorityTx(account accounts.Account, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	return nil, accounts.ErrNotSupported
+}
+
+// SignPriorityTxWithPassphrase implements accounts.Wallet, however priority
+// signatures are not supported by hardware wallets, so this method will always
+// return an error.
+func (w *wallet) SignPriorityTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, signer types.Signer) (*types.Transaction, error) {
+	return nil, accounts.ErrNotSupported
+}
@@ -1065,6 +1065,24 @@ func GenDoc(ctx *cli.Context) {
 				Input:    nil,
 			}})
 	}
+	{ // Sign priority transaction request
+		desc := "SignPriorityTxRequest contains information about a pending request to sign a priority transaction. " +
+			"It's the same as SignTxRequest, plus the `priority_signer` account adding the priority signature " +
+			"once the sender has signed. The response is a SignTxResponse."
+
+		add("SignPriorityTxRequest", desc, &core.SignPriorityTxRequest{
+			Meta:           meta,
+			PrioritySigner: common.NewMixedcaseAddress(a),
+			Transaction: apitypes.SendTxArgs{
+				Nonce:                0x1,
+				Value:                hexutil.Big(*big.NewInt(6)),
+				From:                 common.NewMixedcaseAddress(a),
+				To:                   nil,
+				MaxFeePerGas:         (*hexutil.Big)(big.NewInt(0)),
+				MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(0)),
+				Gas:                  1000,
+			}})
+	}
 	{ // Sign tx response
 		data := hexutil.Bytes([]byte{0x04, 0x03, 0x02, 0x01})
 		add("SignTxResponse - approve", "Response to request to sign a transaction. This response needs to contain the `transaction`"+
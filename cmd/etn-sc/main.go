@@ -82,6 +82,7 @@ var (
 		utils.TxPoolLocalsFlag,
 		utils.TxPoolNoLocalsFlag,
 		utils.TxPoolJournalFlag,
+		utils.TxPoolPriorityJournalFlag,
 		utils.TxPoolRejournalFlag,
 		utils.TxPoolPriceLimitFlag,
 		utils.TxPoolPriceBumpFlag,
@@ -91,12 +92,17 @@ var (
 		utils.TxPoolAccountQueueFlag,
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolPriorityQueueFlag,
+		utils.TxPoolPriorityMaxPendingFlag,
+		utils.TxPoolPriorityMaxBlockGasFlag,
+		utils.TxPoolPriorityMaxWindowGasFlag,
+		utils.TxPoolPriorityWindowFlag,
 		utils.TxPoolLifetimeFlag,
 		utils.SyncModeFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
 		utils.SnapshotFlag,
 		utils.TxLookupLimitFlag,
+		utils.TraceIndexFlag,
 		utils.LightServeFlag,
 		utils.LightIngressFlag,
 		utils.LightEgressFlag,
@@ -134,6 +140,10 @@ var (
 		utils.MinerRecommitIntervalFlag,
 		utils.MinerNoVerifyFlag,
 		utils.MinerPrioritiseElectroneumFlag,
+		utils.IstanbulRoundJournalFlag,
+		utils.IstanbulValidatorPeersFlag,
+		utils.IstanbulMessageRateFlag,
+		utils.IstanbulMessageBurstFlag,
 		utils.NATFlag,
 		utils.NoDiscoverFlag,
 		utils.DiscoveryV5Flag,
@@ -243,6 +253,8 @@ func init() {
 		utils.ShowDeprecated,
 		// See snapshot.go
 		snapshotCommand,
+		// See emissioncmd.go
+		emissionCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -43,6 +43,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.ExitWhenSyncedFlag,
 			utils.GCModeFlag,
 			utils.TxLookupLimitFlag,
+			utils.TraceIndexFlag,
 			utils.EthStatsURLFlag,
 			utils.IdentityFlag,
 			utils.LightKDFFlag,
@@ -90,6 +91,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.TxPoolLocalsFlag,
 			utils.TxPoolNoLocalsFlag,
 			utils.TxPoolJournalFlag,
+			utils.TxPoolPriorityJournalFlag,
 			utils.TxPoolRejournalFlag,
 			utils.TxPoolPriceLimitFlag,
 			utils.TxPoolPriceBumpFlag,
@@ -99,6 +101,10 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.TxPoolAccountQueueFlag,
 			utils.TxPoolGlobalQueueFlag,
 			utils.TxPoolPriorityQueueFlag,
+			utils.TxPoolPriorityMaxPendingFlag,
+			utils.TxPoolPriorityMaxBlockGasFlag,
+			utils.TxPoolPriorityMaxWindowGasFlag,
+			utils.TxPoolPriorityWindowFlag,
 			utils.TxPoolLifetimeFlag,
 		},
 	},
@@ -194,6 +200,15 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.MinerPrioritiseElectroneumFlag,
 		},
 	},
+	{
+		Name: "ISTANBUL",
+		Flags: []cli.Flag{
+			utils.IstanbulRoundJournalFlag,
+			utils.IstanbulValidatorPeersFlag,
+			utils.IstanbulMessageRateFlag,
+			utils.IstanbulMessageBurstFlag,
+		},
+	},
 	{
 		Name: "GAS PRICE ORACLE",
 		Flags: []cli.Flag{
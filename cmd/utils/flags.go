@@ -38,6 +38,8 @@ import (
 	"github.com/electroneum/electroneum-sc/common/fdlimit"
 	"github.com/electroneum/electroneum-sc/consensus"
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/network"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
 	"github.com/electroneum/electroneum-sc/core/vm"
@@ -228,6 +230,10 @@ var (
 		Usage: "Number of recent blocks to maintain transactions index for (default = about one year, 0 = entire chain)",
 		Value: ethconfig.Defaults.TxLookupLimit,
 	}
+	TraceIndexFlag = cli.BoolFlag{
+		Name:  "trace.index",
+		Usage: "Index the call traces of newly imported blocks, serving them from the database in the tracing APIs",
+	}
 	LightKDFFlag = cli.BoolFlag{
 		Name:  "lightkdf",
 		Usage: "Reduce key-derivation RAM & CPU usage at some expense of KDF strength",
@@ -348,6 +354,11 @@ var (
 		Usage: "Disk journal for local transaction to survive node restarts",
 		Value: core.DefaultTxPoolConfig.Journal,
 	}
+	TxPoolPriorityJournalFlag = cli.StringFlag{
+		Name:  "txpool.priorityjournal",
+		Usage: "Disk journal for remote priority transactions to survive node restarts (disabled if empty)",
+		Value: core.DefaultTxPoolConfig.PriorityJournal,
+	}
 	TxPoolRejournalFlag = cli.DurationFlag{
 		Name:  "txpool.rejournal",
 		Usage: "Time interval to regenerate the local transaction journal",
@@ -393,6 +404,26 @@ var (
 		Usage: "Maximum number of non-executable priority transaction slots for all accounts",
 		Value: ethconfig.Defaults.TxPool.PriorityQueue,
 	}
+	TxPoolPriorityMaxPendingFlag = cli.Uint64Flag{
+		Name:  "txpool.priority.maxpending",
+		Usage: "Maximum number of gas price waived transactions per priority transactor in the pool (0 = no limit)",
+		Value: ethconfig.Defaults.TxPool.PriorityQuota.MaxPending,
+	}
+	TxPoolPriorityMaxBlockGasFlag = cli.Uint64Flag{
+		Name:  "txpool.priority.maxblockgas",
+		Usage: "Maximum gas of gas price waived transactions per priority transactor in a block (0 = no limit)",
+		Value: ethconfig.Defaults.TxPool.PriorityQuota.MaxBlockGas,
+	}
+	TxPoolPriorityMaxWindowGasFlag = cli.Uint64Flag{
+		Name:  "txpool.priority.maxwindowgas",
+		Usage: "Maximum gas of gas price waived transactions per priority transactor within the rolling window (0 = no limit)",
+		Value: ethconfig.Defaults.TxPool.PriorityQuota.MaxWindowGas,
+	}
+	TxPoolPriorityWindowFlag = cli.Uint64Flag{
+		Name:  "txpool.priority.window",
+		Usage: "Number of blocks in the rolling window limiting the gas of gas price waived transactions",
+		Value: ethconfig.Defaults.TxPool.PriorityQuota.Window,
+	}
 	TxPoolLifetimeFlag = cli.DurationFlag{
 		Name:  "txpool.lifetime",
 		Usage: "Maximum amount of time non-executable transaction are queued",
@@ -496,6 +527,25 @@ var (
 		Name:  "miner.PrioritiseElectroneum",
 		Usage: "Prioritise Electroneum Ltd Transactions when filling blocks",
 	}
+	// Istanbul settings
+	IstanbulRoundJournalFlag = cli.BoolFlag{
+		Name:  "istanbul.roundjournal",
+		Usage: "Record the messages and timeouts of every consensus round on disk (istanbul_getRoundHistory)",
+	}
+	IstanbulValidatorPeersFlag = cli.BoolFlag{
+		Name:  "istanbul.validatorpeers",
+		Usage: "Only accept consensus messages from peers in the current validator set",
+	}
+	IstanbulMessageRateFlag = cli.Float64Flag{
+		Name:  "istanbul.msgrate",
+		Usage: "Maximum number of consensus messages per second accepted from a single peer",
+		Value: ethconfig.Defaults.IstanbulNetwork.MessageRate,
+	}
+	IstanbulMessageBurstFlag = cli.IntFlag{
+		Name:  "istanbul.msgburst",
+		Usage: "Maximum number of consensus messages accepted at once from a single peer",
+		Value: ethconfig.Defaults.IstanbulNetwork.MessageBurst,
+	}
 	// Account settings
 	UnlockedAccountFlag = cli.StringFlag{
 		Name:  "unlock",
@@ -1406,6 +1456,9 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolJournalFlag.Name) {
 		cfg.Journal = ctx.GlobalString(TxPoolJournalFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolPriorityJournalFlag.Name) {
+		cfg.PriorityJournal = ctx.GlobalString(TxPoolPriorityJournalFlag.Name)
+	}
 	if ctx.GlobalIsSet(TxPoolRejournalFlag.Name) {
 		cfg.Rejournal = ctx.GlobalDuration(TxPoolRejournalFlag.Name)
 	}
@@ -1436,6 +1489,24 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
+	setPriorityQuota(ctx, &cfg.PriorityQuota)
+}
+
+// setPriorityQuota applies the priority transactor limits to both the pool and
+// the miner, which enforce them on admission and block building respectively.
+func setPriorityQuota(ctx *cli.Context, cfg *core.PriorityQuotaConfig) {
+	if ctx.GlobalIsSet(TxPoolPriorityMaxPendingFlag.Name) {
+		cfg.MaxPending = ctx.GlobalUint64(TxPoolPriorityMaxPendingFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolPriorityMaxBlockGasFlag.Name) {
+		cfg.MaxBlockGas = ctx.GlobalUint64(TxPoolPriorityMaxBlockGasFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolPriorityMaxWindowGasFlag.Name) {
+		cfg.MaxWindowGas = ctx.GlobalUint64(TxPoolPriorityMaxWindowGasFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolPriorityWindowFlag.Name) {
+		cfg.Window = ctx.GlobalUint64(TxPoolPriorityWindowFlag.Name)
+	}
 }
 
 func setEthash(ctx *cli.Context, cfg *ethconfig.Config) {
@@ -1465,6 +1536,24 @@ func setEthash(ctx *cli.Context, cfg *ethconfig.Config) {
 	}
 }
 
+func setIstanbul(ctx *cli.Context, cfg *istanbul.Config) {
+	if ctx.GlobalIsSet(IstanbulRoundJournalFlag.Name) {
+		cfg.RoundJournal = ctx.GlobalBool(IstanbulRoundJournalFlag.Name)
+	}
+}
+
+func setIstanbulNetwork(ctx *cli.Context, cfg *network.Config) {
+	if ctx.GlobalIsSet(IstanbulValidatorPeersFlag.Name) {
+		cfg.ValidatorsOnly = ctx.GlobalBool(IstanbulValidatorPeersFlag.Name)
+	}
+	if ctx.GlobalIsSet(IstanbulMessageRateFlag.Name) {
+		cfg.MessageRate = ctx.GlobalFloat64(IstanbulMessageRateFlag.Name)
+	}
+	if ctx.GlobalIsSet(IstanbulMessageBurstFlag.Name) {
+		cfg.MessageBurst = ctx.GlobalInt(IstanbulMessageBurstFlag.Name)
+	}
+}
+
 func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.GlobalIsSet(MinerNotifyFlag.Name) {
 		cfg.Notify = strings.Split(ctx.GlobalString(MinerNotifyFlag.Name), ",")
@@ -1492,6 +1581,7 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 		log.Warn("In using --PrioritiseElectroneum you have decided to Prioritise Electroneum's transactions when mining")
 		cfg.PrioritiseElectroneum = ctx.GlobalBool(MinerPrioritiseElectroneumFlag.Name)
 	}
+	setPriorityQuota(ctx, &cfg.PriorityQuota)
 }
 
 func setRequiredBlocks(ctx *cli.Context, cfg *ethconfig.Config) {
@@ -1585,6 +1675,8 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	setTxPool(ctx, &cfg.TxPool)
 	setEthash(ctx, cfg)
 	setMiner(ctx, &cfg.Miner)
+	setIstanbul(ctx, &cfg.Istanbul)
+	setIstanbulNetwork(ctx, &cfg.IstanbulNetwork)
 	setRequiredBlocks(ctx, cfg)
 	setLes(ctx, cfg)
 	// Cap the cache allowance and tune the garbage collector
@@ -1639,6 +1731,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.GlobalUint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.GlobalIsSet(TraceIndexFlag.Name) {
+		cfg.TraceIndex = ctx.GlobalBool(TraceIndexFlag.Name)
+	}
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheTrieFlag.Name) / 100
 	}
@@ -1829,7 +1924,15 @@ func RegisterEthService(stack *node.Node, cfg *ethconfig.Config) (ethapi.Backend
 			Fatalf("Failed to register the catalyst service: %v", err)
 		}
 	}
+	if backend.BlockChain().Config().IBFT != nil {
+		if _, err := network.New(stack, backend.Engine(), cfg.IstanbulNetwork); err != nil {
+			Fatalf("Failed to register the consensus network service: %v", err)
+		}
+	}
 	stack.RegisterAPIs(tracers.APIs(backend.APIBackend))
+	if cfg.TraceIndex {
+		stack.RegisterLifecycle(tracers.NewIndexer(backend.APIBackend))
+	}
 	return backend.APIBackend, backend
 }
 
@@ -443,6 +443,15 @@ func (ma *MixedcaseAddress) Original() string {
 type PriorityTransactor struct {
 	IsGasPriceWaiver bool
 	EntityName       string
+	Limits           PriorityTransactorLimits
+}
+
+// PriorityTransactorLimits caps the gas price waived transactions of a priority
+// transactor. A zero field means the limit is not set.
+type PriorityTransactorLimits struct {
+	MaxPending   uint64 // Maximum number of waived transactions in the pool
+	MaxBlockGas  uint64 // Maximum gas of waived transactions in a single block
+	MaxWindowGas uint64 // Maximum gas of waived transactions within the rolling window
 }
 
 // PublicKey represents the 65 byte *uncompressed* secp256k1 pubkey used for priority signatures within txes of PriorityTx type
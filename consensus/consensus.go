@@ -126,6 +126,28 @@ type Engine interface {
 	Close() error
 }
 
+// BlockReward is an amount credited to an account when a block is finalized.
+type BlockReward struct {
+	Beneficiary common.Address
+	Amount      *big.Int
+}
+
+// Rewarder should be implemented by the consensus engines crediting block
+// rewards in Finalize, to report them without finalizing a block.
+type Rewarder interface {
+	// BlockRewards returns the rewards credited when finalizing the given header.
+	BlockRewards(chain ChainHeaderReader, header *types.Header) ([]BlockReward, error)
+}
+
+// StateVerifier should be implemented by the consensus engines committing in the
+// header to parts of the state, which can only be checked once the block has been
+// processed.
+type StateVerifier interface {
+	// VerifyState checks the header against the state after the transactions of
+	// the block and its finalization were applied.
+	VerifyState(chain ChainHeaderReader, header *types.Header, state *state.StateDB) error
+}
+
 // Handler should be implemented is the consensus needs to handle and send peer's message
 type Handler interface {
 	// NewChainHead handles a new head block comes
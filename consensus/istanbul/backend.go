@@ -74,6 +74,14 @@ type Backend interface {
 	// HasBadProposal returns whether the block with the hash is a bad block
 	HasBadProposal(hash common.Hash) bool
 
+	// RoundJournal returns the journal consensus rounds are recorded in, nil if
+	// journaling is disabled
+	RoundJournal() RoundJournal
+
+	// ReportEquivocation records the evidence of a validator signing conflicting
+	// messages
+	ReportEquivocation(evidence *EquivocationEvidence)
+
 	Close() error
 
 	// StartQBFTConsensus stops existing legacy ibft consensus and starts the new qbft consensus
@@ -0,0 +1,251 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/json"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/event"
+)
+
+const (
+	dbKeyActivityPrefix = "istanbul-activity"
+
+	// maxActivityCatchUp is the maximum number of blocks indexed at once when the
+	// chain head moves, older blocks are indexed on demand by the API
+	maxActivityCatchUp = 1024
+)
+
+// BlockActivity is the validator participation recorded for a single block.
+type BlockActivity struct {
+	Number     uint64           `json:"number"`
+	Hash       common.Hash      `json:"hash"`
+	Round      uint32           `json:"round"`      // Round the block got committed in
+	Proposer   common.Address   `json:"proposer"`   // Validator that proposed the committed block
+	Missed     []common.Address `json:"missed"`     // Proposers of the rounds that were changed before the block got committed
+	Committers []common.Address `json:"committers"` // Validators whose committed seal is included in the block
+}
+
+// ValidatorActivity is the participation of a single validator over a block range.
+type ValidatorActivity struct {
+	Proposed uint64 `json:"proposed"` // Number of committed blocks proposed by the validator
+	Missed   uint64 `json:"missed"`   // Number of rounds changed while the validator was the proposer
+	Seals    uint64 `json:"seals"`    // Number of blocks including a committed seal of the validator
+}
+
+// ActivityReport is the per validator participation over a block range.
+type ActivityReport struct {
+	From         uint64                                `json:"from"`
+	To           uint64                                `json:"to"`
+	RoundChanges uint64                                `json:"roundChanges"`
+	Validators   map[common.Address]*ValidatorActivity `json:"validators"`
+}
+
+// ActivityChain is the chain access required to index the validator activity of
+// newly imported blocks.
+type ActivityChain interface {
+	consensus.ChainHeaderReader
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// loadBlockActivity loads the activity of a block from the database.
+func loadBlockActivity(db ethdb.Database, hash common.Hash) (*BlockActivity, error) {
+	blob, err := db.Get(append([]byte(dbKeyActivityPrefix), hash[:]...))
+	if err != nil {
+		return nil, err
+	}
+	activity := new(BlockActivity)
+	if err := json.Unmarshal(blob, activity); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// store inserts the block activity into the database.
+func (a *BlockActivity) store(db ethdb.Database) error {
+	blob, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return db.Put(append([]byte(dbKeyActivityPrefix), a.Hash[:]...), blob)
+}
+
+// newActivityReport creates an empty report for the given block range.
+func newActivityReport(from, to uint64) *ActivityReport {
+	return &ActivityReport{
+		From:       from,
+		To:         to,
+		Validators: make(map[common.Address]*ValidatorActivity),
+	}
+}
+
+// add accounts the activity of a single block into the report.
+func (r *ActivityReport) add(activity *BlockActivity) {
+	get := func(addr common.Address) *ValidatorActivity {
+		if _, ok := r.Validators[addr]; !ok {
+			r.Validators[addr] = new(ValidatorActivity)
+		}
+		return r.Validators[addr]
+	}
+	get(activity.Proposer).Proposed++
+	for _, addr := range activity.Missed {
+		get(addr).Missed++
+	}
+	for _, addr := range activity.Committers {
+		get(addr).Seals++
+	}
+	r.RoundChanges += uint64(activity.Round)
+}
+
+// blockActivity retrieves the activity of the given block, computing and storing
+// it into the database if it wasn't indexed yet.
+func (sb *Backend) blockActivity(chain consensus.ChainHeaderReader, header *types.Header) (*BlockActivity, error) {
+	if activity, err := loadBlockActivity(sb.db, header.Hash()); err == nil {
+		return activity, nil
+	}
+	activity, err := sb.computeBlockActivity(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	if err := activity.store(sb.db); err != nil {
+		sb.logger.Error("IBFT: failed to store block activity", "number", activity.Number, "hash", activity.Hash, "err", err)
+		return nil, err
+	}
+	return activity, nil
+}
+
+// computeBlockActivity derives the activity of a block from its header. The
+// proposers of the rounds before the committed one are recalculated the same way
+// the core does when changing rounds.
+func (sb *Backend) computeBlockActivity(chain consensus.ChainHeaderReader, header *types.Header) (*BlockActivity, error) {
+	extra, err := types.ExtractQBFTExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := sb.Author(header)
+	if err != nil {
+		return nil, err
+	}
+	committers, err := sb.Signers(header)
+	if err != nil {
+		return nil, err
+	}
+	activity := &BlockActivity{
+		Number:     header.Number.Uint64(),
+		Hash:       header.Hash(),
+		Round:      extra.Round,
+		Proposer:   proposer,
+		Missed:     []common.Address{},
+		Committers: committers,
+	}
+	if extra.Round == 0 {
+		return activity, nil
+	}
+
+	number := header.Number.Uint64() - 1
+	snap, err := sb.snapshot(chain, number, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	var lastProposer common.Address
+	if number > 0 {
+		parent := chain.GetHeader(header.ParentHash, number)
+		if parent == nil {
+			return nil, consensus.ErrUnknownAncestor
+		}
+		if lastProposer, err = sb.Author(parent); err != nil {
+			return nil, err
+		}
+	}
+	// Use a detached validator set so the shared proposer policy isn't touched
+	policy := istanbul.NewProposerPolicyByIdAndSortFunc(snap.ValSet.Policy().Id, istanbul.ValidatorSortByByte())
+	var valSet istanbul.ValidatorSet
+	if weights, sequence := validator.Weights(sb.contractWeightedSet(chain, snap.ValSet, number, header.ParentHash)); weights != nil {
+		valSet = validator.NewWeightedSet(snap.validators(), policy, weights, sequence)
+	} else {
+		valSet = validator.NewSet(snap.validators(), policy)
+	}
+	for round := uint64(0); round < uint64(extra.Round); round++ {
+		valSet.CalcProposer(lastProposer, round)
+		if p := valSet.GetProposer(); p != nil {
+			activity.Missed = append(activity.Missed, p.Address())
+		}
+	}
+	return activity, nil
+}
+
+// SubscribeBlockActivity subscribes to the activity of blocks indexed as they
+// become the chain head.
+func (sb *Backend) SubscribeBlockActivity(ch chan<- *BlockActivity) event.Subscription {
+	return sb.activityScope.Track(sb.activityFeed.Subscribe(ch))
+}
+
+// TrackActivity starts indexing the validator activity of the blocks becoming
+// the head of the given chain, until the engine is closed.
+func (sb *Backend) TrackActivity(chain ActivityChain) {
+	sb.activityOnce.Do(func() {
+		sb.activityWg.Add(1)
+		go sb.activityLoop(chain)
+	})
+}
+
+func (sb *Backend) activityLoop(chain ActivityChain) {
+	defer sb.activityWg.Done()
+
+	heads := make(chan core.ChainHeadEvent, 10)
+	sub := chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-heads:
+			sb.indexActivity(chain, head.Block.Header())
+		case <-sub.Err():
+			return
+		case <-sb.activityQuit:
+			return
+		}
+	}
+}
+
+// indexActivity indexes the given head and any of its ancestors that were imported
+// in the same batch, publishing their activity in chain order.
+func (sb *Backend) indexActivity(chain consensus.ChainHeaderReader, head *types.Header) {
+	var headers []*types.Header
+	for header := head; header != nil && header.Number.Sign() > 0 && len(headers) < maxActivityCatchUp; {
+		if has, _ := sb.db.Has(append([]byte(dbKeyActivityPrefix), header.Hash().Bytes()...)); has {
+			break
+		}
+		headers = append(headers, header)
+		header = chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	}
+	for i := len(headers) - 1; i >= 0; i-- {
+		activity, err := sb.blockActivity(chain, headers[i])
+		if err != nil {
+			sb.logger.Warn("IBFT: failed to index block activity", "number", headers[i].Number, "hash", headers[i].Hash(), "err", err)
+			return
+		}
+		sb.activityFeed.Send(activity)
+	}
+}
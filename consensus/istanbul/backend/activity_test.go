@@ -0,0 +1,128 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	qbftengine "github.com/electroneum/electroneum-sc/consensus/istanbul/engine"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+func TestComputeBlockActivityRoundChanges(t *testing.T) {
+	chain, engine := newBlockChain(3)
+	defer engine.Stop()
+
+	// Commit a block in round 2, so the proposers of rounds 0 and 1 missed
+	block := makeBlockWithoutSeal(chain, engine, chain.Genesis(), true)
+	header := block.Header()
+	seal, err := engine.SignWithoutHashing(qbftengine.PrepareCommittedSeal(header, 2))
+	if err != nil {
+		t.Fatalf("failed to sign committed seal: %v", err)
+	}
+	if err := engine.EngineForBlockNumber(header.Number).CommitHeader(header, [][]byte{seal}, big.NewInt(2)); err != nil {
+		t.Fatalf("failed to commit header: %v", err)
+	}
+
+	activity, err := engine.computeBlockActivity(chain, header)
+	if err != nil {
+		t.Fatalf("failed to compute activity: %v", err)
+	}
+	validators := engine.getValidators(0, chain.Genesis().Hash())
+	want := &BlockActivity{
+		Number:     1,
+		Hash:       header.Hash(),
+		Round:      2,
+		Proposer:   engine.Address(),
+		Missed:     []common.Address{validators.GetByIndex(0).Address(), validators.GetByIndex(1).Address()},
+		Committers: []common.Address{engine.address},
+	}
+	if !reflect.DeepEqual(activity, want) {
+		t.Errorf("activity mismatch: have %+v, want %+v", activity, want)
+	}
+
+	report := newActivityReport(1, 1)
+	report.add(activity)
+	if report.RoundChanges != 2 {
+		t.Errorf("round changes mismatch: have %d, want 2", report.RoundChanges)
+	}
+	for i, want := range []uint64{1, 1, 0} {
+		addr := validators.GetByIndex(uint64(i)).Address()
+		if have := report.Validators[addr]; (have == nil && want != 0) || (have != nil && have.Missed != want) {
+			t.Errorf("validator %d: missed mismatch: have %v, want %d", i, have, want)
+		}
+	}
+}
+
+func TestValidatorActivity(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+	defer engine.Close()
+
+	activities := make(chan *BlockActivity, 10)
+	sub := engine.SubscribeBlockActivity(activities)
+	defer sub.Unsubscribe()
+	engine.TrackActivity(chain)
+
+	block1 := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block1}); err != nil {
+		t.Fatalf("failed to insert block 1: %v", err)
+	}
+	engine.NewChainHead()
+	block2 := makeBlock(chain, engine, block1)
+	if _, err := chain.InsertChain(types.Blocks{block2}); err != nil {
+		t.Fatalf("failed to insert block 2: %v", err)
+	}
+
+	for _, block := range []*types.Block{block1, block2} {
+		select {
+		case activity := <-activities:
+			if activity.Hash != block.Hash() {
+				t.Errorf("activity hash mismatch: have %v, want %v", activity.Hash, block.Hash())
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for activity of block %d", block.NumberU64())
+		}
+	}
+
+	api := &API{chain: chain, backend: engine}
+	from, to := rpc.BlockNumber(0), rpc.LatestBlockNumber
+	report, err := api.GetValidatorActivity(&from, &to)
+	if err != nil {
+		t.Fatalf("failed to get validator activity: %v", err)
+	}
+	want := &ActivityReport{
+		From:       1,
+		To:         2,
+		Validators: map[common.Address]*ValidatorActivity{engine.Address(): {Proposed: 2, Seals: 2}},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("report mismatch: have %+v, want %+v", report, want)
+	}
+	// Ranges too long to walk must be rejected upfront
+	to = rpc.BlockNumber(maxActivityBlocks)
+	if _, err := api.GetValidatorActivity(&from, &to); err == nil {
+		t.Error("expected an error for a range exceeding the limit")
+	}
+	// Closing must be idempotent, the deferred close runs again
+	engine.Close()
+}
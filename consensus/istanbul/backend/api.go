@@ -17,16 +17,41 @@
 package backend
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
 	istanbulcommon "github.com/electroneum/electroneum-sc/consensus/istanbul/common"
+	qbftengine "github.com/electroneum/electroneum-sc/consensus/istanbul/engine"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
 	"github.com/electroneum/electroneum-sc/core/types"
 	"github.com/electroneum/electroneum-sc/rpc"
 )
 
+// errRoundJournalDisabled is returned when the round history of a height is
+// requested while the round journal is disabled.
+var errRoundJournalDisabled = errors.New("round journal disabled")
+
+// maxActivityBlocks is the maximum number of blocks a validator activity report
+// can cover, as every block's snapshot and seals are looked at.
+const maxActivityBlocks = 10000
+
+// maxEquivocationBlocks is the maximum number of heights an equivocation evidence
+// query can cover, as all the evidence recorded for them is loaded.
+const maxEquivocationBlocks = 10000
+
+// maxValidatorSetProofBlocks is the maximum number of blocks a validator set proof
+// can cover, as every block's snapshot is looked at.
+const maxValidatorSetProofBlocks = 100000
+
+// maxEmissionHistoryEntries is the maximum number of entries an emission history
+// can hold, as the emission at every entry gets looked up.
+var maxEmissionHistoryEntries uint64 = 10000
+
 // API is a user facing RPC API to dump Istanbul state
 type API struct {
 	chain   consensus.ChainHeaderReader
@@ -41,6 +66,24 @@ type BlockSigners struct {
 	Committers []common.Address
 }
 
+// ValidatorSetProof is the validator set in force after a given block, along with
+// the headers changing it up to another block.
+type ValidatorSetProof struct {
+	Number     uint64                           `json:"number"`
+	Validators []common.Address                 `json:"validators"`
+	Changes    []*qbftengine.ValidatorSetChange `json:"changes"`
+}
+
+// Supply is the ETN supply after a given block. The net supply is the circulating
+// supply minus the base fees burned.
+type Supply struct {
+	Number            uint64      `json:"number"`
+	Hash              common.Hash `json:"hash"`
+	CirculatingSupply *big.Int    `json:"circulatingsupply"`
+	Burned            *big.Int    `json:"burned"`
+	NetSupply         *big.Int    `json:"netsupply"`
+}
+
 type Status struct {
 	SigningStatus map[common.Address]int `json:"sealerActivity"`
 	NumBlocks     uint64                 `json:"numBlocks"`
@@ -318,3 +361,374 @@ func (api *API) GetTotalEmission(blockNum *rpc.BlockNumber) (*big.Int, error) {
 
 	return api.backend.GetTotalEmission(api.chain, header), nil
 }
+
+// GetSupply returns the circulating supply, the base fees burned and the net
+// supply after the given block, or the latest block if none is specified.
+func (api *API) GetSupply(blockNum *rpc.BlockNumber) (*Supply, error) {
+	var header *types.Header
+	if blockNum == nil || *blockNum == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(blockNum.Int64()))
+	}
+	if header == nil {
+		return nil, istanbulcommon.ErrUnknownBlock
+	}
+	emission, err := api.backend.emission(api.chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	burn, err := api.backend.burn(api.chain, header.Number.Uint64(), header.Hash())
+	if err != nil {
+		return nil, err
+	}
+	return &Supply{
+		Number:            header.Number.Uint64(),
+		Hash:              header.Hash(),
+		CirculatingSupply: emission.CirculatingSupply,
+		Burned:            burn.Burned,
+		NetSupply:         new(big.Int).Sub(emission.CirculatingSupply, burn.Burned),
+	}, nil
+}
+
+// GetEmissionHistory returns the circulating supply after every step-th block
+// within the given range, both ends included. The step defaults to the emission
+// checkpoint interval, and the range may not hold more entries than allowed.
+func (api *API) GetEmissionHistory(from rpc.BlockNumber, to rpc.BlockNumber, step *uint64) ([]*Emission, error) {
+	var (
+		head  = api.chain.CurrentHeader().Number.Uint64()
+		start = uint64(from.Int64())
+		end   = uint64(to.Int64())
+	)
+	if from == rpc.LatestBlockNumber {
+		start = head
+	}
+	if to == rpc.LatestBlockNumber {
+		end = head
+	}
+	if start > end {
+		return nil, errors.New("start block number should be less than end block number")
+	}
+	if end > head {
+		return nil, errors.New("end block number should be less than or equal to current block height")
+	}
+	interval := uint64(checkpointInterval)
+	if step != nil {
+		if *step == 0 {
+			return nil, errors.New("step should be greater than zero")
+		}
+		interval = *step
+	}
+	if (end-start)/interval >= maxEmissionHistoryEntries {
+		return nil, fmt.Errorf("emission history should not exceed %d entries, use a larger step", maxEmissionHistoryEntries)
+	}
+
+	history := []*Emission{}
+	for n := start; n <= end; n += interval {
+		header := api.chain.GetHeaderByNumber(n)
+		if header == nil {
+			return nil, istanbulcommon.ErrUnknownBlock
+		}
+		emission, err := api.backend.emission(api.chain, n, header.Hash(), nil)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, emission)
+
+		// Stop before wrapping around
+		if n+interval < n {
+			break
+		}
+	}
+	return history, nil
+}
+
+// GetValidatorActivity returns the proposals made, the proposals missed and the
+// committed seals included by each validator within the given block range, both
+// ends included. The last 64 blocks are reported if no range is given.
+func (api *API) GetValidatorActivity(from *rpc.BlockNumber, to *rpc.BlockNumber) (*ActivityReport, error) {
+	var (
+		head  = api.chain.CurrentHeader().Number.Uint64()
+		start uint64
+		end   uint64
+	)
+	switch {
+	case from != nil && to == nil:
+		return nil, errors.New("pass the end block number")
+	case from == nil && to != nil:
+		return nil, errors.New("pass the start block number")
+	case from == nil && to == nil:
+		end = head
+		if end > 64 {
+			start = end - 63
+		}
+	default:
+		start, end = uint64(from.Int64()), uint64(to.Int64())
+		if *from == rpc.LatestBlockNumber {
+			start = head
+		}
+		if *to == rpc.LatestBlockNumber {
+			end = head
+		}
+		if start > end {
+			return nil, errors.New("start block number should be less than end block number")
+		}
+		if end-start >= maxActivityBlocks {
+			return nil, fmt.Errorf("block range should not exceed %d blocks", maxActivityBlocks)
+		}
+		if end > head {
+			return nil, errors.New("end block number should be less than or equal to current block height")
+		}
+	}
+	// The genesis block has no proposer nor seals
+	if start == 0 {
+		start = 1
+	}
+
+	report := newActivityReport(start, end)
+	for n := start; n <= end; n++ {
+		header := api.chain.GetHeaderByNumber(n)
+		if header == nil {
+			return nil, istanbulcommon.ErrUnknownBlock
+		}
+		activity, err := api.backend.blockActivity(api.chain, header)
+		if err != nil {
+			return nil, err
+		}
+		report.add(activity)
+	}
+	return report, nil
+}
+
+// GetRoundHistory returns the messages received and the round change timeouts of
+// every consensus round of the given height, as recorded by the round journal.
+// The latest block refers to the chain head and pending to the height currently
+// being agreed on.
+func (api *API) GetRoundHistory(number rpc.BlockNumber) (*istanbul.RoundHistory, error) {
+	var sequence uint64
+	switch number {
+	case rpc.LatestBlockNumber:
+		sequence = api.chain.CurrentHeader().Number.Uint64()
+	case rpc.PendingBlockNumber:
+		sequence = api.chain.CurrentHeader().Number.Uint64() + 1
+	default:
+		sequence = uint64(number.Int64())
+	}
+	if journal := api.backend.roundJournal; journal != nil {
+		journal.flush() // Include the entries not written yet
+	}
+	history, err := loadRoundHistory(api.backend.db, sequence)
+	if err != nil {
+		if api.backend.roundJournal == nil {
+			return nil, errRoundJournalDisabled
+		}
+		return nil, nil
+	}
+	return history, nil
+}
+
+// GetEquivocationEvidence returns the evidence of validators signing conflicting
+// messages recorded for the heights within the given range, both ends included.
+// The range ends at the height being decided if no end is given, and covers as
+// many heights as allowed from its start, or up to its end, if either is not
+// given.
+func (api *API) GetEquivocationEvidence(from *rpc.BlockNumber, to *rpc.BlockNumber) ([]*istanbul.EquivocationEvidence, error) {
+	var (
+		head  = api.chain.CurrentHeader().Number.Uint64()
+		start uint64
+		end   = head + 1
+	)
+	if to != nil {
+		end = uint64(to.Int64())
+		if *to == rpc.LatestBlockNumber {
+			end = head
+		}
+	}
+	if from != nil {
+		start = uint64(from.Int64())
+		if *from == rpc.LatestBlockNumber {
+			start = head
+		}
+		if to == nil {
+			end = start + maxEquivocationBlocks - 1
+		}
+	} else if end >= maxEquivocationBlocks {
+		start = end - maxEquivocationBlocks + 1
+	}
+	if start > end {
+		return nil, errors.New("start block number should be less than end block number")
+	}
+	if end-start >= maxEquivocationBlocks {
+		return nil, fmt.Errorf("block range should not exceed %d blocks", maxEquivocationBlocks)
+	}
+	return loadEquivocationEvidence(api.backend.db, start, end)
+}
+
+// GetValidatorSetProof returns the validator set in force after the start block,
+// and every header changing it up to the end block, committed seals included.
+// Changes voted in by headers come with the headers since the last checkpoint to
+// replay the votes from. The changes can be checked with
+// Engine.VerifyValidatorSetChanges without fetching any other header. The range
+// ends at the head if no end is given, and covers as many blocks as allowed up to
+// its end if no start is given; longer spans have to be proven in several steps.
+func (api *API) GetValidatorSetProof(from *rpc.BlockNumber, to *rpc.BlockNumber) (*ValidatorSetProof, error) {
+	var (
+		head  = api.chain.CurrentHeader().Number.Uint64()
+		start uint64
+		end   = head
+	)
+	if to != nil {
+		end = uint64(to.Int64())
+		if *to == rpc.LatestBlockNumber {
+			end = head
+		}
+	}
+	if from != nil {
+		start = uint64(from.Int64())
+		if *from == rpc.LatestBlockNumber {
+			start = head
+		}
+	} else if end >= maxValidatorSetProofBlocks {
+		start = end - maxValidatorSetProofBlocks + 1
+	}
+	if start > end {
+		return nil, errors.New("start block number should be less than end block number")
+	}
+	if end-start >= maxValidatorSetProofBlocks {
+		return nil, fmt.Errorf("block range should not exceed %d blocks", maxValidatorSetProofBlocks)
+	}
+	if end > head {
+		return nil, errors.New("end block number should be less than or equal to current block height")
+	}
+
+	header := api.chain.GetHeaderByNumber(start)
+	if header == nil {
+		return nil, istanbulcommon.ErrUnknownBlock
+	}
+	snap, err := api.backend.snapshot(api.chain, start, header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	proof := &ValidatorSetProof{
+		Number:     start,
+		Validators: validator.SortedAddresses(snap.ValSet.List()),
+		Changes:    []*qbftengine.ValidatorSetChange{},
+	}
+	current := proof.Validators
+	for n := start + 1; n <= end; n++ {
+		if header = api.chain.GetHeaderByNumber(n); header == nil {
+			return nil, istanbulcommon.ErrUnknownBlock
+		}
+		if snap, err = api.backend.snapshot(api.chain, n, header.Hash(), nil); err != nil {
+			return nil, err
+		}
+		validators := validator.SortedAddresses(snap.ValSet.List())
+		if !sameAddresses(current, validators) {
+			change := &qbftengine.ValidatorSetChange{Header: header, Validators: validators}
+			if change.Votes, err = api.voteHeaders(header); err != nil {
+				return nil, err
+			}
+			proof.Changes = append(proof.Changes, change)
+			current = validators
+		}
+	}
+	return proof, nil
+}
+
+// voteHeaders returns the headers since the last checkpoint before the given one,
+// whose votes led to the validator set change it made. Checkpoints and headers
+// of the validator contract mode change the validator set without votes.
+func (api *API) voteHeaders(header *types.Header) ([]*types.Header, error) {
+	if api.backend.config.IsValidatorContractMode(header.Number) {
+		return nil, nil
+	}
+	var (
+		number = header.Number.Uint64()
+		epoch  = api.backend.config.GetConfig(header.Number).Epoch
+		votes  = make([]*types.Header, number%epoch)
+	)
+	for i := len(votes) - 1; i >= 0; i-- {
+		if header = api.chain.GetHeader(header.ParentHash, header.Number.Uint64()-1); header == nil {
+			return nil, istanbulcommon.ErrUnknownBlock
+		}
+		votes[i] = header
+	}
+	return votes, nil
+}
+
+// sameAddresses reports whether two sorted address lists are equal.
+func sameAddresses(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidatorActivity creates a subscription that fires with the validator activity
+// of every block that becomes the chain head.
+func (api *API) ValidatorActivity(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		activities := make(chan *BlockActivity, 16)
+		activitySub := api.backend.SubscribeBlockActivity(activities)
+		defer activitySub.Unsubscribe()
+
+		for {
+			select {
+			case activity := <-activities:
+				notifier.Notify(rpcSub.ID, activity)
+			case <-activitySub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// Equivocation creates a subscription that fires with the evidence of every
+// validator equivocation detected.
+func (api *API) Equivocation(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		evidences := make(chan *istanbul.EquivocationEvidence, 16)
+		evidenceSub := api.backend.SubscribeEquivocation(evidences)
+		defer evidenceSub.Unsubscribe()
+
+		for {
+			select {
+			case evidence := <-evidences:
+				notifier.Notify(rpcSub.ID, evidence)
+			case <-evidenceSub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
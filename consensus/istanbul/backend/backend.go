@@ -49,6 +49,7 @@ func New(config istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database
 	// Allocate the snapshot caches and create the engine
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentsEmission, _ := lru.NewARC(inmemoryEmissions)
+	recentsBurn, _ := lru.NewARC(inmemoryEmissions)
 	recentsBlockSnapshot, _ := lru.NewARC(inmemoryBlockSnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
@@ -63,15 +64,21 @@ func New(config istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database
 		commitCh:             make(chan *types.Block, 1),
 		recents:              recents,
 		recentsEmission:      recentsEmission,
+		recentsBurn:          recentsBurn,
 		recentsBlockSnapshot: recentsBlockSnapshot,
 		candidates:           make(map[common.Address]bool),
 		coreStarted:          false,
 		recentMessages:       recentMessages,
 		knownMessages:        knownMessages,
+		activityQuit:         make(chan struct{}),
 	}
 
 	sb.qbftEngine = qbftengine.NewEngine(sb.config, sb.address, sb.Sign)
 
+	if config.RoundJournal {
+		sb.roundJournal = newRoundJournal(db, sb.logger)
+	}
+
 	return sb
 }
 
@@ -112,14 +119,36 @@ type Backend struct {
 	recents *lru.ARCCache
 	// Emission for recent blocks
 	recentsEmission *lru.ARCCache
+	// Burned base fees for recent blocks
+	recentsBurn *lru.ARCCache
 	// Block Snapshot for recent blocks
 	recentsBlockSnapshot *lru.ARCCache
+	// Validators of the block following the chain head, keyed by the head hash
+	headValidators     istanbul.ValidatorSet
+	headValidatorsHash common.Hash
+	headValidatorsLock sync.Mutex
 
 	// event subscription for ChainHeadEvent event
 	broadcaster consensus.Broadcaster
 
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
+
+	// validator activity indexing of new chain heads
+	activityFeed  event.Feed
+	activityScope event.SubscriptionScope
+	activityOnce  sync.Once
+	activityWg    sync.WaitGroup
+	activityQuit  chan struct{}
+
+	closeOnce sync.Once // Close may be called by both the node and the chain
+
+	// evidence of validators signing conflicting messages
+	equivocationFeed  event.Feed
+	equivocationScope event.SubscriptionScope
+
+	// journal of the consensus rounds, nil if disabled
+	roundJournal *roundJournal
 }
 
 func (sb *Backend) Engine() istanbul.Engine {
@@ -321,7 +350,7 @@ func (sb *Backend) getValidators(number uint64, hash common.Hash) istanbul.Valid
 	if err != nil {
 		return validator.NewSet(nil, sb.config.ProposerPolicy)
 	}
-	return snap.ValSet
+	return sb.contractWeightedSet(sb.chain, snap.ValSet, number, hash)
 }
 
 func (sb *Backend) LastProposal() (istanbul.Proposal, common.Address) {
@@ -349,6 +378,15 @@ func (sb *Backend) HasBadProposal(hash common.Hash) bool {
 }
 
 func (sb *Backend) Close() error {
+	sb.closeOnce.Do(func() {
+		close(sb.activityQuit)
+		sb.activityWg.Wait()
+		sb.activityScope.Close()
+		sb.equivocationScope.Close()
+		if sb.roundJournal != nil {
+			sb.roundJournal.close()
+		}
+	})
 	return nil
 }
 
@@ -381,6 +419,14 @@ func (sb *Backend) stop() error {
 	return nil
 }
 
+// RoundJournal implements istanbul.Backend.RoundJournal
+func (sb *Backend) RoundJournal() istanbul.RoundJournal {
+	if sb.roundJournal == nil {
+		return nil
+	}
+	return sb.roundJournal
+}
+
 // StartQBFTConsensus stops existing legacy ibft consensus and starts the new qbft consensus
 func (sb *Backend) StartQBFTConsensus() error {
 	if err := sb.stop(); err != nil {
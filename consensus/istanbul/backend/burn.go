@@ -0,0 +1,233 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	istanbulcommon "github.com/electroneum/electroneum-sc/consensus/istanbul/common"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/log"
+)
+
+const (
+	dbKeyBurnPrefix = "istanbul-burn"
+)
+
+// errMissingBurnData is returned when the base fees burned by a block can't be
+// computed because its body or receipts aren't available.
+var errMissingBurnData = errors.New("block body or receipts unavailable")
+
+// Burn is the amount of ETN burned as base fees up to a given point in time.
+// It's tracked apart from the emission, as the circulating supply feeds the block
+// rewards and must not depend on the availability of receipts.
+type Burn struct {
+	Number uint64
+	Hash   common.Hash
+	Burned *big.Int
+}
+
+func newBurn(blockNumber uint64, hash common.Hash, burned *big.Int) *Burn {
+	if burned == nil {
+		burned = big.NewInt(0)
+	}
+	return &Burn{
+		Number: blockNumber,
+		Hash:   hash,
+		Burned: burned,
+	}
+}
+
+// loadBurn loads an existing burn snapshot from the database.
+func loadBurn(hash common.Hash, db ethdb.KeyValueReader) (*Burn, error) {
+	blob, err := db.Get(append([]byte(dbKeyBurnPrefix), hash[:]...))
+	if err != nil {
+		return nil, err
+	}
+	burn := new(Burn)
+	if err := json.Unmarshal(blob, burn); err != nil {
+		return nil, err
+	}
+	return burn, nil
+}
+
+// store inserts the burn snapshot into the database.
+func (b *Burn) store(db ethdb.KeyValueWriter) error {
+	blob, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	return db.Put(append([]byte(dbKeyBurnPrefix), b.Hash[:]...), blob)
+}
+
+// copy creates a deep copy of the burn snapshot
+func (b *Burn) copy() *Burn {
+	cpy := &Burn{
+		Number: b.Number,
+		Hash:   b.Hash,
+	}
+	if b.Burned != nil {
+		cpy.Burned = new(big.Int).Set(b.Burned)
+	}
+	return cpy
+}
+
+type burnJSON struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+	Burned *big.Int    `json:"burned"`
+}
+
+// Unmarshal from a json byte array
+func (b *Burn) UnmarshalJSON(input []byte) error {
+	var j burnJSON
+	if err := json.Unmarshal(input, &j); err != nil {
+		return err
+	}
+	b.Number = j.Number
+	b.Hash = j.Hash
+	b.Burned = j.Burned
+
+	return nil
+}
+
+// Marshal to a json byte array
+func (b *Burn) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&burnJSON{
+		Number: b.Number,
+		Hash:   b.Hash,
+		Burned: b.Burned,
+	})
+}
+
+// blockBurn returns the base fees burned by the transactions of a block. Every
+// transaction burns the base fee for the gas it used, except for priority ones
+// waived from paying for gas, which burn nothing as their fee cap is zero.
+func blockBurn(db ethdb.Reader, header *types.Header) (*big.Int, error) {
+	burned := new(big.Int)
+	if header.BaseFee == nil || header.GasUsed == 0 {
+		return burned, nil
+	}
+	var (
+		hash     = header.Hash()
+		number   = header.Number.Uint64()
+		body     = rawdb.ReadBody(db, hash, number)
+		receipts = rawdb.ReadRawReceipts(db, hash, number)
+	)
+	if body == nil || receipts == nil || len(body.Transactions) != len(receipts) {
+		return nil, errMissingBurnData
+	}
+	var cumulative uint64
+	for i, tx := range body.Transactions {
+		gasUsed := receipts[i].CumulativeGasUsed - cumulative
+		cumulative = receipts[i].CumulativeGasUsed
+
+		price := header.BaseFee
+		if tx.GasFeeCap().Cmp(price) < 0 {
+			price = tx.GasFeeCap()
+		}
+		burned.Add(burned, new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), price))
+	}
+	return burned, nil
+}
+
+func (sb *Backend) burnLogger(burn *Burn) log.Logger {
+	return sb.logger.New(
+		"burn.number", burn.Number,
+		"burn.hash", burn.Hash.String(),
+		"burn.burned", burn.Burned,
+	)
+}
+
+func (sb *Backend) storeBurn(burn *Burn) error {
+	logger := sb.burnLogger(burn)
+	logger.Debug("IBFT: store burn to database")
+	if err := burn.store(sb.db); err != nil {
+		logger.Error("IBFT: failed to store burn to database", "err", err)
+		return err
+	}
+	return nil
+}
+
+// burn retrieves the base fees burned up to a given block, the same way emission
+// retrieves the circulating supply.
+func (sb *Backend) burn(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (*Burn, error) {
+	var (
+		headers []*types.Header
+		burn    *Burn
+	)
+	for burn == nil {
+		// If an in-memory burn was found, use that
+		if b, ok := sb.recentsBurn.Get(hash); ok {
+			burn = b.(*Burn)
+			break
+		}
+		// If an on-disk checkpoint burn can be found, use that
+		if number%checkpointInterval == 0 {
+			if b, err := loadBurn(hash, sb.db); err == nil {
+				burn = b
+				break
+			}
+		}
+		// Nothing is burned by the genesis block
+		if number == 0 {
+			genesis := chain.GetHeaderByNumber(0)
+			if genesis == nil {
+				return nil, istanbulcommon.ErrUnknownBlock
+			}
+			burn = newBurn(0, genesis.Hash(), nil)
+			if err := sb.storeBurn(burn); err != nil {
+				return nil, err
+			}
+			break
+		}
+		// No burn for this header, gather the header and move backward
+		header := chain.GetHeader(hash, number)
+		if header == nil {
+			return nil, consensus.ErrUnknownAncestor
+		}
+		headers = append(headers, header)
+		number, hash = number-1, header.ParentHash
+	}
+
+	// Previous burn found, apply any pending headers on top of it
+	burn = burn.copy()
+	for i := len(headers) - 1; i >= 0; i-- {
+		burned, err := blockBurn(sb.db, headers[i])
+		if err != nil {
+			return nil, err
+		}
+		burn.Burned.Add(burn.Burned, burned)
+		burn.Number, burn.Hash = headers[i].Number.Uint64(), headers[i].Hash()
+
+		// Cache the checkpoints crossed, so that long walks are not repeated
+		if burn.Number%checkpointInterval == 0 {
+			if err := sb.storeBurn(burn); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sb.recentsBurn.Add(burn.Hash, burn)
+
+	return burn, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+)
+
+func TestBlockBurn(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	header := &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(7), GasUsed: 60000}
+
+	// Fee caps above the base fee burn the base fee, and waived ones burn nothing
+	txs := types.Transactions{
+		types.NewTx(&types.DynamicFeeTx{Nonce: 0, Gas: 21000, GasFeeCap: big.NewInt(10), GasTipCap: big.NewInt(1)}),
+		types.NewTx(&types.DynamicFeeTx{Nonce: 1, Gas: 21000, GasFeeCap: big.NewInt(0), GasTipCap: big.NewInt(0)}),
+		types.NewTx(&types.LegacyTx{Nonce: 2, Gas: 30000, GasPrice: big.NewInt(8)}),
+	}
+	receipts := types.Receipts{
+		{CumulativeGasUsed: 21000},
+		{CumulativeGasUsed: 42000},
+		{CumulativeGasUsed: 60000},
+	}
+	if _, err := blockBurn(db, header); err != errMissingBurnData {
+		t.Fatalf("error mismatch: have %v, want %v", err, errMissingBurnData)
+	}
+	rawdb.WriteBody(db, header.Hash(), 1, &types.Body{Transactions: txs})
+	rawdb.WriteReceipts(db, header.Hash(), 1, receipts)
+
+	burned, err := blockBurn(db, header)
+	if err != nil {
+		t.Fatalf("failed to compute burn: %v", err)
+	}
+	if want := big.NewInt(7 * (21000 + 18000)); burned.Cmp(want) != 0 {
+		t.Errorf("burn mismatch: have %v, want %v", burned, want)
+	}
+}
+
+func TestGetSupply(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	api := &API{chain: chain, backend: engine}
+	supply, err := api.GetSupply(nil)
+	if err != nil {
+		t.Fatalf("failed to get supply: %v", err)
+	}
+	genesisETN := chain.Config().GenesisETN
+	if genesisETN == nil {
+		genesisETN = new(big.Int)
+	}
+	if supply.Burned.Sign() != 0 || supply.CirculatingSupply.Cmp(genesisETN) != 0 || supply.NetSupply.Cmp(genesisETN) != 0 {
+		t.Errorf("supply mismatch: have %+v, want %v", supply, genesisETN)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/electroneum/electroneum-sc/accounts/abi"
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
+	"github.com/electroneum/electroneum-sc/contracts/proposerweights"
+	"github.com/electroneum/electroneum-sc/contracts/validatorset"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/params"
+)
+
+var (
+	// errNoStateReader is returned if a consensus contract has to be called but the
+	// chain is unable to provide the required state.
+	errNoStateReader = errors.New("chain does not provide state access for consensus contracts")
+
+	// errInvalidCheckpointValidators is returned if the validators carried by a
+	// checkpoint header don't match the ones read from the validator contract.
+	errInvalidCheckpointValidators = errors.New("checkpoint validators mismatch the validator contract")
+)
+
+// stateChain is implemented by chains which can provide the state of a given
+// block, as required to call the consensus contracts outside block processing.
+type stateChain interface {
+	consensus.ChainHeaderReader
+	StateAt(root common.Hash) (*state.StateDB, error)
+}
+
+// stateAt returns the state right after the given header was applied.
+func stateAt(chain consensus.ChainHeaderReader, header *types.Header) (*state.StateDB, error) {
+	sc, ok := chain.(stateChain)
+	if !ok {
+		return nil, errNoStateReader
+	}
+	return sc.StateAt(header.Root)
+}
+
+// chainContext adapts a header reader to the core.ChainContext needed by the EVM
+// when calling the consensus contracts.
+type chainContext struct {
+	consensus.ChainHeaderReader
+	engine consensus.Engine
+}
+
+// Engine implements core.ChainContext.
+func (c *chainContext) Engine() consensus.Engine {
+	return c.engine
+}
+
+// callContract executes a read only call of a contract method against the given
+// state of the header, returning the unpacked outputs. The state is left as is.
+//
+// Failures of the contract are deterministic across nodes, so they are only
+// logged and reported by an empty result, letting the caller fall back to a
+// default.
+func (sb *Backend) callContract(chain consensus.ChainHeaderReader, header *types.Header, statedb *state.StateDB, address common.Address, contractABI string, method string, args ...interface{}) []interface{} {
+	logger := sb.logger.New("address", address, "method", method, "number", header.Number.Uint64())
+
+	if len(statedb.GetCode(address)) == 0 {
+		logger.Error("IBFT: contract not deployed")
+		return nil
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		logger.Error("IBFT: failed to parse contract ABI", "err", err)
+		return nil
+	}
+
+	input, err := parsed.Pack(method, args...)
+	if err != nil {
+		logger.Error("IBFT: failed to pack contract call data", "err", err)
+		return nil
+	}
+
+	// Call on a copy, as the EVM touches the accounts it calls
+	blockContext := core.NewEVMBlockContext(header, &chainContext{chain, sb}, &common.Address{})
+	evm := vm.NewEVM(blockContext, vm.TxContext{}, statedb.Copy(), chain.Config(), vm.Config{})
+	output, _, err := evm.StaticCall(vm.AccountRef(address), address, input, params.MaxGasLimit)
+	if err != nil {
+		logger.Error("IBFT: contract call failed", "err", err)
+		return nil
+	}
+
+	unpackResult, err := parsed.Unpack(method, output)
+	if err != nil || len(unpackResult) == 0 {
+		logger.Error("IBFT: contract returned invalid data", "err", err)
+		return nil
+	}
+	return unpackResult
+}
+
+// contractValidators reads the validator set from the configured validator
+// contract, using the given state of the header. An empty result means the
+// previous validator set has to be kept.
+func (sb *Backend) contractValidators(chain consensus.ChainHeaderReader, header *types.Header, statedb *state.StateDB) []common.Address {
+	address := sb.config.GetConfig(header.Number).ValidatorContract
+
+	result := sb.callContract(chain, header, statedb, address, validatorset.ETNValidatorSetInterfaceMetaData.ABI, "getValidators")
+	if result == nil {
+		return nil
+	}
+	validators, ok := result[0].([]common.Address)
+	if !ok {
+		sb.logger.Error("IBFT: validator contract returned unexpected type; keeping previous validators", "address", address)
+		return nil
+	}
+
+	// Drop duplicates and zero addresses so a misconfigured contract can not
+	// inflate the quorum size
+	var (
+		validatorSet = make([]common.Address, 0, len(validators))
+		seen         = make(map[common.Address]bool)
+	)
+	for _, v := range validators {
+		if v == (common.Address{}) || seen[v] {
+			continue
+		}
+		seen[v] = true
+		validatorSet = append(validatorSet, v)
+	}
+	return validatorSet
+}
+
+// checkpointValidators returns the validators a header has to carry in its extra
+// data while the validator set is read from the validator contract. Checkpoint
+// headers carry the set the contract reports after the block was applied, which
+// is in force from the next block on, or the current set if the contract reports
+// none. Nil is returned for any other header.
+func (sb *Backend) checkpointValidators(chain consensus.ChainHeaderReader, header *types.Header, statedb *state.StateDB) ([]common.Address, error) {
+	if !sb.config.IsValidatorContractMode(header.Number) {
+		return nil, nil
+	}
+	snap, err := sb.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	if header.Number.Uint64()%snap.Epoch != 0 {
+		return nil, nil
+	}
+	valSet := snap.ValSet
+	if validators := sb.contractValidators(chain, header, statedb); len(validators) > 0 {
+		valSet = validator.NewSet(validators, sb.config.ProposerPolicy)
+	}
+	return validator.SortedAddresses(valSet.List()), nil
+}
+
+// contractWeightedSet rebinds a weighted validator set, proposing for the block
+// after the given one, to the weights of the proposer weights contract read from
+// the state right after that block. Weights only drive the proposer election,
+// which header verification never checks, so they are read when running the
+// consensus only. The set is returned as is if there is no such contract or its
+// weights can't be read.
+func (sb *Backend) contractWeightedSet(chain consensus.ChainHeaderReader, valSet istanbul.ValidatorSet, number uint64, hash common.Hash) istanbul.ValidatorSet {
+	weights, sequence := validator.Weights(valSet)
+	if weights == nil || sb.config.GetConfig(new(big.Int).SetUint64(sequence)).ProposerWeightsContract == (common.Address{}) {
+		return valSet
+	}
+	header := chain.GetHeader(hash, number)
+	if header == nil {
+		return valSet
+	}
+	validators := make([]common.Address, 0, valSet.Size())
+	for _, val := range valSet.List() {
+		validators = append(validators, val.Address())
+	}
+	contractWeights, err := sb.contractProposerWeights(chain, header, validators)
+	if err != nil {
+		sb.logger.Error("IBFT: failed to read proposer weights from contract", "number", number, "err", err)
+		return valSet
+	}
+	if contractWeights == nil {
+		return valSet
+	}
+	policy := istanbul.NewProposerPolicyByIdAndSortFunc(istanbul.Weighted, istanbul.ValidatorSortByByte())
+	return validator.NewWeightedSet(validators, policy, contractWeights, sequence)
+}
+
+// contractProposerWeights reads the proposer weight of each of the given
+// validators from the configured proposer weights contract, using the state right
+// after the given header was applied. An empty result means the weights of the
+// transitions have to be used.
+func (sb *Backend) contractProposerWeights(chain consensus.ChainHeaderReader, header *types.Header, validators []common.Address) (map[common.Address]uint64, error) {
+	address := sb.config.GetConfig(new(big.Int).Add(header.Number, common.Big1)).ProposerWeightsContract
+
+	statedb, err := stateAt(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	result := sb.callContract(chain, header, statedb, address, proposerweights.ETNProposerWeightsInterfaceMetaData.ABI, "getProposerWeights", validators)
+	if result == nil {
+		return nil, nil
+	}
+	values, ok := result[0].([]*big.Int)
+	if !ok || len(values) != len(validators) {
+		sb.logger.Error("IBFT: proposer weights contract returned unexpected data; using configured weights", "address", address)
+		return nil, nil
+	}
+	weights := make(map[common.Address]uint64, len(validators))
+	for i, value := range values {
+		if !value.IsUint64() {
+			weights[validators[i]] = math.MaxUint64
+			continue
+		}
+		weights[validators[i]] = value.Uint64()
+	}
+	return weights, nil
+}
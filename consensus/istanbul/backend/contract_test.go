@@ -0,0 +1,192 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	qbftengine "github.com/electroneum/electroneum-sc/consensus/istanbul/engine"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/testutils"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+// validatorContractCode returns runtime bytecode that answers any call with the
+// ABI encoding of the given addresses as an address[].
+func validatorContractCode(validators []common.Address) []byte {
+	ret := common.LeftPadBytes(big.NewInt(32).Bytes(), 32)
+	ret = append(ret, common.LeftPadBytes(big.NewInt(int64(len(validators))).Bytes(), 32)...)
+	for _, v := range validators {
+		ret = append(ret, common.LeftPadBytes(v.Bytes(), 32)...)
+	}
+	size := []byte{byte(len(ret) >> 8), byte(len(ret))}
+
+	// PUSH2 size PUSH1 0x0e PUSH1 0 CODECOPY PUSH2 size PUSH1 0 RETURN
+	code := []byte{0x61, size[0], size[1], 0x60, 0x0e, 0x60, 0x00, 0x39, 0x61, size[0], size[1], 0x60, 0x00, 0xf3}
+	return append(code, ret...)
+}
+
+func TestContractValidatorSelection(t *testing.T) {
+	genesis, nodeKeys := testutils.GenesisAndKeys(1)
+	contractAddr := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	extra := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	// The contract keeps the genesis validator and adds a new one
+	genesisValidators := []common.Address{crypto.PubkeyToAddress(nodeKeys[0].PublicKey)}
+	validators := []common.Address{genesisValidators[0], extra}
+	chainConfig := *genesis.Config
+	chainConfig.Transitions = []params.Transition{{
+		Block:                    big.NewInt(0),
+		ValidatorSelectionMode:   params.ContractMode,
+		ValidatorContractAddress: contractAddr,
+	}}
+	genesis.Config = &chainConfig
+	genesis.Alloc = core.GenesisAlloc{contractAddr: {Code: validatorContractCode(validators), Balance: common.Big0}}
+
+	config := copyConfig(istanbul.DefaultConfig)
+	config.Epoch = 2
+	config.Transitions = chainConfig.Transitions
+	chain, engine := newBlockchainFromConfig(genesis, nodeKeys, config)
+	defer engine.Stop()
+
+	// The contract set is only picked up from the next checkpoint header on
+	api := &API{chain: chain, backend: engine}
+	want := append([]common.Address{}, validators...)
+	sort.Slice(want, func(i, j int) bool { return bytes.Compare(want[i][:], want[j][:]) < 0 })
+
+	parent := chain.Genesis()
+	for i, expect := range [][]common.Address{genesisValidators, genesisValidators, want} {
+		if i > 0 {
+			block := makeBlock(chain, engine, parent)
+			if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+				t.Fatalf("block %d: failed to insert: %v", i, err)
+			}
+			parent = block
+		}
+		number := rpc.BlockNumber(i)
+		have, err := api.GetValidators(&number)
+		if err != nil {
+			t.Fatalf("block %d: failed to get validators: %v", i, err)
+		}
+		if !reflect.DeepEqual(have, expect) {
+			t.Errorf("block %d: validators mismatch: have %v, want %v", i, have, expect)
+		}
+	}
+	// Checkpoints not carrying the contract set must be rejected on processing
+	statedb, err := chain.StateAt(parent.Root())
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if err := engine.VerifyState(chain, parent.Header(), statedb); err != nil {
+		t.Errorf("valid checkpoint rejected: %v", err)
+	}
+	header := parent.Header()
+	if err := qbftengine.ApplyHeaderQBFTExtra(header, qbftengine.WriteValidators(genesisValidators)); err != nil {
+		t.Fatalf("failed to write validators: %v", err)
+	}
+	if err := engine.VerifyState(chain, header, statedb); err != errInvalidCheckpointValidators {
+		t.Errorf("invalid checkpoint error mismatch: have %v, want %v", err, errInvalidCheckpointValidators)
+	}
+
+	// Votes must not be written to headers while in contract mode
+	engine.candidates[common.HexToAddress("0x00000000000000000000000000000000000000bb")] = true
+	header = makeHeader(parent, engine.config)
+	if err := engine.Prepare(chain, header); err != nil {
+		t.Fatalf("failed to prepare header: %v", err)
+	}
+	candidate, _, err := engine.EngineForBlockNumber(header.Number).ReadVote(header)
+	if err != nil {
+		t.Fatalf("failed to read vote: %v", err)
+	}
+	if candidate != (common.Address{}) {
+		t.Errorf("vote mismatch: have %v, want none", candidate)
+	}
+}
+
+func TestWeightedProposerPolicy(t *testing.T) {
+	genesis, nodeKeys := testutils.GenesisAndKeys(3)
+	validators := make([]common.Address, len(nodeKeys))
+	for i, key := range nodeKeys {
+		validators[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	sort.Slice(validators, func(i, j int) bool { return bytes.Compare(validators[i][:], validators[j][:]) < 0 })
+
+	// The weights contract answers with the same words whatever the validators
+	// asked for, so encode the weights like the addresses of an address[]
+	contractAddr := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	contractWeights := []common.Address{common.BigToAddress(big.NewInt(5)), common.BigToAddress(big.NewInt(0)), common.BigToAddress(big.NewInt(2))}
+	configWeights := map[common.Address]uint64{validators[1]: 4}
+
+	tests := []struct {
+		transition params.Transition
+		want       map[common.Address]uint64
+	}{
+		{
+			params.Transition{ProposerPolicy: params.WeightedProposerPolicy, ProposerWeights: configWeights},
+			configWeights,
+		},
+		{
+			params.Transition{ProposerPolicy: params.WeightedProposerPolicy, ProposerWeights: configWeights, ProposerWeightsContractAddress: contractAddr},
+			map[common.Address]uint64{validators[0]: 5, validators[1]: 0, validators[2]: 2},
+		},
+	}
+	for i, test := range tests {
+		test.transition.Block = big.NewInt(0)
+		chainConfig := *genesis.Config
+		chainConfig.Transitions = []params.Transition{test.transition}
+		genesis.Config = &chainConfig
+		genesis.Alloc = core.GenesisAlloc{contractAddr: {Code: validatorContractCode(contractWeights), Balance: common.Big0}}
+
+		config := copyConfig(istanbul.DefaultConfig)
+		config.Transitions = chainConfig.Transitions
+		chain, engine := newBlockchainFromConfig(genesis, nodeKeys, config)
+
+		snap, err := engine.snapshot(chain, 0, chain.Genesis().Hash(), nil)
+		if err != nil {
+			t.Fatalf("test %d: failed to get snapshot: %v", i, err)
+		}
+		// Snapshots never read state, so they carry the weights of the transitions
+		weights, sequence := validator.Weights(snap.ValSet)
+		if !reflect.DeepEqual(weights, configWeights) || sequence != 1 {
+			t.Errorf("test %d: weights mismatch: have %v at %d, want %v at 1", i, weights, sequence, configWeights)
+		}
+		// The weights must survive the snapshot being stored and loaded
+		stored, err := loadSnapshot(snap.Epoch, engine.db, snap.Hash)
+		if err != nil {
+			t.Fatalf("test %d: failed to load snapshot: %v", i, err)
+		}
+		if weights, sequence := validator.Weights(stored.ValSet); !reflect.DeepEqual(weights, configWeights) || sequence != 1 {
+			t.Errorf("test %d: stored weights mismatch: have %v at %d, want %v at 1", i, weights, sequence, configWeights)
+		}
+		// The contract weights only apply to the set running the consensus
+		valSet := engine.getValidators(0, chain.Genesis().Hash())
+		if weights, sequence := validator.Weights(valSet); !reflect.DeepEqual(weights, test.want) || sequence != 1 {
+			t.Errorf("test %d: consensus weights mismatch: have %v at %d, want %v at 1", i, weights, sequence, test.want)
+		}
+		engine.Stop()
+	}
+}
@@ -19,9 +19,15 @@ package backend
 import (
 	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/math"
+	"github.com/electroneum/electroneum-sc/consensus"
+	istanbulcommon "github.com/electroneum/electroneum-sc/consensus/istanbul/common"
 	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/params"
 )
 
 const (
@@ -49,7 +55,7 @@ func newEmission(blockNumber uint64, hash common.Hash, circulatingSupply *big.In
 }
 
 // loadEmission loads an existing emission snapshot from the database.
-func loadEmission(hash common.Hash, db ethdb.Database) (*Emission, error) {
+func loadEmission(hash common.Hash, db ethdb.KeyValueReader) (*Emission, error) {
 	blob, err := db.Get(append([]byte(dbKeyEmissionPrefix), hash[:]...))
 	if err != nil {
 		return nil, err
@@ -117,3 +123,129 @@ func (e *Emission) MarshalJSON() ([]byte, error) {
 	j := e.toJSONStruct()
 	return json.Marshal(j)
 }
+
+// EmissionDivergence is a stored emission checkpoint disagreeing with the supply
+// recomputed from the block rewards.
+type EmissionDivergence struct {
+	Number   uint64      `json:"number"`
+	Hash     common.Hash `json:"hash"`
+	Stored   *big.Int    `json:"stored"`
+	Computed *big.Int    `json:"computed"`
+}
+
+// EmissionAudit is the outcome of recomputing the circulating supply of a chain.
+type EmissionAudit struct {
+	Number            uint64                `json:"number"`
+	Hash              common.Hash           `json:"hash"`
+	CirculatingSupply *big.Int              `json:"circulatingsupply"`
+	Checkpoints       uint64                `json:"checkpoints"`
+	Divergences       []*EmissionDivergence `json:"divergences"`
+}
+
+// AuditEmission derives the circulating supply after the given block from the
+// emission schedule, and checks the emission checkpoints stored along the way
+// against the supply derived for their height.
+func AuditEmission(chain consensus.ChainHeaderReader, db ethdb.KeyValueReader, number uint64) (*EmissionAudit, error) {
+	var (
+		config = chain.Config()
+		audit  = &EmissionAudit{Divergences: []*EmissionDivergence{}}
+
+		logged = time.Now()
+	)
+	for n := uint64(0); n <= number; n += checkpointInterval {
+		header := chain.GetHeaderByNumber(n)
+		if header == nil {
+			return nil, istanbulcommon.ErrUnknownBlock
+		}
+		if stored, err := loadEmission(header.Hash(), db); err == nil {
+			audit.Checkpoints++
+			if derived := derivedSupply(config, n); stored.Number != n || stored.CirculatingSupply == nil || stored.CirculatingSupply.Cmp(derived) != 0 {
+				audit.Divergences = append(audit.Divergences, &EmissionDivergence{
+					Number:   n,
+					Hash:     header.Hash(),
+					Stored:   stored.CirculatingSupply,
+					Computed: derived,
+				})
+			}
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Auditing emission", "number", n, "divergences", len(audit.Divergences))
+			logged = time.Now()
+		}
+	}
+	header := chain.GetHeaderByNumber(number)
+	if header == nil {
+		return nil, istanbulcommon.ErrUnknownBlock
+	}
+	audit.Number, audit.Hash = number, header.Hash()
+	audit.CirculatingSupply = derivedSupply(config, number)
+	return audit, nil
+}
+
+// derivedSupply derives the circulating supply after the given block from the
+// emission schedule, summing the rewards of whole halving periods at once rather
+// than accumulating the base reward of every block like the engine does, so the
+// two computations check each other.
+func derivedSupply(config *params.ChainConfig, number uint64) *big.Int {
+	var (
+		supply    = new(big.Int)
+		maxSupply = math.MustParseBig256(params.ETNMaxSupply)
+		offset    = new(big.Int)
+		last      = new(big.Int).SetUint64(number)
+	)
+	if config.GenesisETN != nil {
+		supply.Set(config.GenesisETN)
+	}
+	// Halvings are counted from the legacy chain heights, scaled to 5-second blocks
+	if config.LegacyToSmartchainMigrationHeight != nil {
+		offset.Add(offset, config.LegacyToSmartchainMigrationHeight)
+	}
+	if config.LegacyV9ForkHeight != nil {
+		offset.Sub(offset, config.LegacyV9ForkHeight)
+	}
+	offset.Mul(offset, big.NewInt(24))
+
+	for first := big.NewInt(1); first.Cmp(last) <= 0; {
+		halvings := new(big.Int).Div(new(big.Int).Add(first, offset), halvingPeriod)
+
+		// The period ends right before the block of the next halving
+		end := new(big.Int).Add(halvings, common.Big1)
+		end.Mul(end, halvingPeriod).Sub(end, offset).Sub(end, common.Big1)
+		if end.Cmp(last) > 0 {
+			end.Set(last)
+		}
+		blocks := new(big.Int).Sub(end, first)
+		supply = periodSupply(supply, blocks.Add(blocks, common.Big1).Uint64(), halvings.Uint64(), maxSupply)
+
+		first = end.Add(end, common.Big1)
+	}
+	return supply
+}
+
+// periodSupply adds the rewards of the given number of blocks of a halving period
+// to the supply. The blocks clear of the max supply are summed at once, the ones
+// approaching it, only getting the halved remainder, one at a time.
+func periodSupply(supply *big.Int, blocks uint64, halvings uint64, maxSupply *big.Int) *big.Int {
+	reward := new(big.Int).Rsh(initialBlockReward, uint(halvings))
+
+	// A block is clear of the max supply if its full reward fits before it
+	if margin := new(big.Int).Sub(maxSupply, initialBlockReward); supply.Cmp(margin) <= 0 {
+		clear := blocks
+		if reward.Sign() > 0 {
+			fit := new(big.Int).Sub(margin, supply)
+			if fit.Div(fit, reward).Add(fit, common.Big1); fit.IsUint64() && fit.Uint64() < clear {
+				clear = fit.Uint64()
+			}
+		}
+		supply = new(big.Int).Add(supply, new(big.Int).Mul(reward, new(big.Int).SetUint64(clear)))
+		blocks -= clear
+	}
+	for ; blocks > 0 && supply.Cmp(maxSupply) < 0; blocks-- {
+		remainder := new(big.Int).Sub(maxSupply, supply)
+		if remainder.Rsh(remainder, uint(halvings)); remainder.Sign() == 0 {
+			break
+		}
+		supply = new(big.Int).Add(supply, remainder)
+	}
+	return supply
+}
@@ -21,7 +21,11 @@ import (
 	"testing"
 
 	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/math"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/rpc"
 )
 
 func TestEmissionSaveAndLoad(t *testing.T) {
@@ -47,3 +51,108 @@ func TestEmissionSaveAndLoad(t *testing.T) {
 		t.Errorf("hash mismatch: have %v, want %v", emission1.Hash, emission.Hash)
 	}
 }
+
+func TestAuditEmission(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	parent := chain.Genesis()
+	for i := 0; i < 2; i++ {
+		block := makeBlock(chain, engine, parent)
+		if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("failed to insert block %d: %v", i+1, err)
+		}
+		engine.NewChainHead()
+		parent = block
+	}
+
+	// The recomputed supply must match the one tracked by the engine
+	audit, err := AuditEmission(chain, engine.db, 2)
+	if err != nil {
+		t.Fatalf("failed to audit emission: %v", err)
+	}
+	emission, err := engine.emission(chain, 2, parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to get emission: %v", err)
+	}
+	if audit.Number != 2 || audit.CirculatingSupply.Cmp(emission.CirculatingSupply) != 0 {
+		t.Errorf("audit mismatch: have %d %v, want 2 %v", audit.Number, audit.CirculatingSupply, emission.CirculatingSupply)
+	}
+	if audit.Checkpoints != 1 || len(audit.Divergences) != 0 {
+		t.Errorf("checkpoints mismatch: have %d checked, %d diverging", audit.Checkpoints, len(audit.Divergences))
+	}
+
+	// Tampered checkpoints must be reported
+	forged := newEmission(0, chain.Genesis().Hash(), big.NewInt(1))
+	if err := forged.store(engine.db); err != nil {
+		t.Fatalf("failed to store emission: %v", err)
+	}
+	if audit, err = AuditEmission(chain, engine.db, 2); err != nil {
+		t.Fatalf("failed to audit emission: %v", err)
+	}
+	if len(audit.Divergences) != 1 || audit.Divergences[0].Number != 0 || audit.Divergences[0].Stored.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("divergences mismatch: have %v", audit.Divergences)
+	}
+
+	// The history must follow the emission of the engine
+	api := &API{chain: chain, backend: engine}
+	step := uint64(2)
+	history, err := api.GetEmissionHistory(rpc.BlockNumber(0), rpc.LatestBlockNumber, &step)
+	if err != nil {
+		t.Fatalf("failed to get emission history: %v", err)
+	}
+	if len(history) != 2 || history[0].Number != 0 || history[1].Number != 2 {
+		t.Fatalf("history mismatch: have %v", history)
+	}
+	if history[1].CirculatingSupply.Cmp(emission.CirculatingSupply) != 0 {
+		t.Errorf("supply mismatch: have %v, want %v", history[1].CirculatingSupply, emission.CirculatingSupply)
+	}
+	step = 0
+	if _, err := api.GetEmissionHistory(rpc.BlockNumber(1), rpc.LatestBlockNumber, &step); err == nil {
+		t.Errorf("expected zero step to fail")
+	}
+	// Ranges holding too many entries are rejected, whatever their step
+	defer func(limit uint64) { maxEmissionHistoryEntries = limit }(maxEmissionHistoryEntries)
+	maxEmissionHistoryEntries = 2
+	step = 1
+	if _, err := api.GetEmissionHistory(rpc.BlockNumber(0), rpc.LatestBlockNumber, &step); err == nil {
+		t.Errorf("expected history of 3 entries to fail")
+	}
+}
+
+func TestDerivedSupply(t *testing.T) {
+	maxSupply := math.MustParseBig256(params.ETNMaxSupply)
+
+	tests := []struct {
+		name   string
+		config *params.ChainConfig
+		blocks uint64
+	}{
+		// Halving right at block 24, the legacy heights being scaled by 24
+		{"halving", &params.ChainConfig{
+			GenesisETN:                        big.NewInt(1e18),
+			LegacyToSmartchainMigrationHeight: new(big.Int).SetUint64(halvingPeriod.Uint64()/24 - 1),
+		}, 60},
+		// Rewards shrinking to the remainder right before the max supply
+		{"max supply", &params.ChainConfig{
+			GenesisETN:                        new(big.Int).Sub(maxSupply, big.NewInt(9e18)),
+			LegacyToSmartchainMigrationHeight: new(big.Int).SetUint64(halvingPeriod.Uint64()/24 - 1),
+			LegacyV9ForkHeight:                big.NewInt(1),
+		}, 120},
+	}
+	for _, test := range tests {
+		// The supply derived in closed form must match the block by block one
+		supply := new(big.Int).Set(test.config.GenesisETN)
+		for n := uint64(0); n <= test.blocks; n++ {
+			if n > 0 {
+				supply.Add(supply, baseBlockReward(test.config, new(big.Int).SetUint64(n), supply))
+			}
+			if derived := derivedSupply(test.config, n); derived.Cmp(supply) != 0 {
+				t.Fatalf("%s: supply mismatch at block %d: have %v, want %v", test.name, n, derived, supply)
+			}
+		}
+		if supply.Cmp(maxSupply) > 0 {
+			t.Errorf("%s: supply %v exceeds max supply", test.name, supply)
+		}
+	}
+}
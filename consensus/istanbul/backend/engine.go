@@ -27,6 +27,7 @@ import (
 	"github.com/electroneum/electroneum-sc/consensus"
 	"github.com/electroneum/electroneum-sc/consensus/istanbul"
 	istanbulcommon "github.com/electroneum/electroneum-sc/consensus/istanbul/common"
+	qbftengine "github.com/electroneum/electroneum-sc/consensus/istanbul/engine"
 	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
 	"github.com/electroneum/electroneum-sc/core/state"
 	"github.com/electroneum/electroneum-sc/core/types"
@@ -151,6 +152,11 @@ func (sb *Backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 		return err
 	}
 
+	// Validator votes are ignored while the validator set is read from the contract
+	if sb.config.IsValidatorContractMode(header.Number) {
+		return nil
+	}
+
 	// get valid candidate list
 	sb.candidatesLock.RLock()
 	var addresses []common.Address
@@ -182,20 +188,69 @@ func (sb *Backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 // Note, the block header and state database might be updated to reflect any
 // consensus rules that happen at finalization (e.g. block rewards).
 func (sb *Backend) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) {
-	if header.Coinbase != (common.Address{}) {
-		blockReward := sb.GetBaseBlockReward(chain, header, nil)
-		state.AddBalance(header.Coinbase, blockReward)
+	// The inputs of the reward were resolved when the header was verified or
+	// prepared, so failures here are database errors. Don't take the node down
+	// on them, leaving it to the state root check to reject a block paid
+	// differently.
+	shares, err := sb.creditedRewardShares(chain, header)
+	if err != nil {
+		sb.logger.Error("IBFT: failed to resolve block reward", "number", header.Number, "err", err)
+	}
+	for _, share := range shares {
+		state.AddBalance(share.address, share.amount)
 	}
 	sb.EngineForBlockNumber(header.Number).Finalize(chain, header, state, txs, uncles)
 }
 
+// BlockRewards implements consensus.Rewarder, returning the block reward shares
+// credited by Finalize for the given header.
+func (sb *Backend) BlockRewards(chain consensus.ChainHeaderReader, header *types.Header) ([]consensus.BlockReward, error) {
+	shares, err := sb.creditedRewardShares(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	rewards := make([]consensus.BlockReward, len(shares))
+	for i, share := range shares {
+		rewards[i] = consensus.BlockReward{Beneficiary: share.address, Amount: share.amount}
+	}
+	return rewards, nil
+}
+
 // FinalizeAndAssemble implements consensus.Engine, ensuring no uncles are set,
 // nor block rewards given, and returns the final block.
 func (sb *Backend) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
 	sb.Finalize(chain, header, state, txs, uncles)
+
+	// Checkpoints carry the validator set read from the validator contract
+	validators, err := sb.checkpointValidators(chain, header, state)
+	if err != nil {
+		return nil, err
+	}
+	if validators != nil {
+		if err := qbftengine.ApplyHeaderQBFTExtra(header, qbftengine.WriteValidators(validators)); err != nil {
+			return nil, err
+		}
+	}
 	return sb.EngineForBlockNumber(header.Number).FinalizeAndAssemble(chain, header, state, txs, uncles, receipts)
 }
 
+// VerifyState implements consensus.StateVerifier, checking the validators carried
+// by checkpoint headers against the validator contract.
+func (sb *Backend) VerifyState(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+	want, err := sb.checkpointValidators(chain, header, state)
+	if err != nil || want == nil {
+		return err
+	}
+	have, err := sb.EngineForBlockNumber(header.Number).Validators(header)
+	if err != nil {
+		return err
+	}
+	if !sameAddresses(have, want) {
+		return errInvalidCheckpointValidators
+	}
+	return nil
+}
+
 // Seal generates a new block for the given input block with the local miner's
 // seal place on top.
 func (sb *Backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
@@ -256,34 +311,43 @@ func (sb *Backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 }
 
 func (sb *Backend) GetBaseBlockReward(chain consensus.ChainHeaderReader, header *types.Header, circulatingSupply *big.Int) *big.Int {
+	if circulatingSupply == nil {
+		// Get current circulating supply
+		emission, err := sb.emission(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to get next base block reward: %v", err))
+		}
+
+		circulatingSupply = emission.CirculatingSupply
+	}
+	return baseBlockReward(chain.Config(), header.Number, circulatingSupply)
+}
+
+var (
+	halvingPeriod      = big.NewInt(25228800) // 4 years in 5-second block time
+	initialBlockReward = big.NewInt(4e+18)    // ~100ETN every 120 seconds
+)
+
+// baseBlockReward returns the base reward of the block with the given number, on
+// top of the given circulating supply.
+func baseBlockReward(config *params.ChainConfig, number *big.Int, circulatingSupply *big.Int) *big.Int {
 	// original heights * 24 to make them compatible with 5-second block time
-	rawV9 := chain.Config().LegacyV9ForkHeight
+	rawV9 := config.LegacyV9ForkHeight
 	if rawV9 == nil {
 		rawV9 = big.NewInt(0)
 	}
-	rawMig := chain.Config().LegacyToSmartchainMigrationHeight
+	rawMig := config.LegacyToSmartchainMigrationHeight
 	if rawMig == nil {
 		rawMig = big.NewInt(0)
 	}
 	var legacyV9ForkHeight = new(big.Int).Mul(rawV9, big.NewInt(24))
 	var legacyToSmartchainMigrationHeight = new(big.Int).Mul(rawMig, big.NewInt(24))
 
-	var halvingPeriod = big.NewInt(25228800)                                             // 4 years in 5-second block time
-	var baseReward = big.NewInt(4e+18)                                                   // ~100ETN every 120 seconds
+	var baseReward = new(big.Int).Set(initialBlockReward)
 	var offset = new(big.Int).Sub(legacyToSmartchainMigrationHeight, legacyV9ForkHeight) // block height at time of BC migration - legacyV9ForkHeight
-	var offsetBlockNumber = new(big.Int).Add(header.Number, offset)
+	var offsetBlockNumber = new(big.Int).Add(number, offset)
 	var halvings = new(big.Int).Div(offsetBlockNumber, halvingPeriod) // (blockNumber + offset) / halvingPeriod
 
-	if circulatingSupply == nil {
-		// Get current circulating supply
-		emission, err := sb.emission(chain, header.Number.Uint64()-1, header.ParentHash, nil)
-		if err != nil {
-			panic(fmt.Sprintf("Failed to get next base block reward: %v", err))
-		}
-
-		circulatingSupply = emission.CirculatingSupply
-	}
-
 	// 0 block reward once circulating supply = max supply & if circsupply+basereward > maxsupply, reduce the base reward accordingly
 	if circulatingSupply.Cmp(math.MustParseBig256(params.ETNMaxSupply)) >= 0 {
 		return big.NewInt(0)
@@ -549,6 +613,7 @@ func (sb *Backend) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 			}
 
 			snap = newSnapshot(sb.config.GetConfig(new(big.Int).SetUint64(number)).Epoch, 0, genesis.Hash(), validator.NewSet(validators, sb.config.ProposerPolicy))
+			sb.snapApplyProposerPolicy(snap)
 			if err := sb.storeSnap(snap); err != nil {
 				return nil, err
 			}
@@ -585,6 +650,9 @@ func (sb *Backend) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 	if err != nil {
 		return nil, err
 	}
+	if len(headers) > 0 {
+		sb.snapApplyProposerPolicy(snap)
+	}
 	sb.recents.Add(snap.Hash, snap)
 
 	// If we've generated a new checkpoint snapshot, save to disk
@@ -643,6 +711,26 @@ func (sb *Backend) snapApplyHeader(snap *Snapshot, header *types.Header) error {
 		snap.Tally = make(map[common.Address]Tally)
 	}
 
+	// Header votes are meaningless while the validator set is read from the
+	// validator contract. The contract set is carried by the checkpoint headers
+	// instead, checked against the contract when the block is processed.
+	if sb.config.IsValidatorContractMode(header.Number) {
+		snap.Votes = nil
+		snap.Tally = make(map[common.Address]Tally)
+
+		if number%snap.Epoch == 0 {
+			validators, err := sb.EngineForBlockNumber(header.Number).Validators(header)
+			if err != nil {
+				logger.Error("IBFT: invalid checkpoint validators", "err", err)
+				return err
+			}
+			if len(validators) > 0 {
+				snap.ValSet = validator.NewSet(validators, sb.config.ProposerPolicy)
+			}
+		}
+		return nil
+	}
+
 	// Resolve the authorization key and check against validators
 	validator, err := sb.EngineForBlockNumber(header.Number).Author(header)
 	if err != nil {
@@ -721,3 +809,21 @@ func (sb *Backend) snapApplyHeader(snap *Snapshot, header *types.Header) error {
 	}
 	return nil
 }
+
+// snapApplyProposerPolicy binds the validator set of the snapshot to the proposer
+// policy the transitions select for the next block, if any. Weighted sets are
+// also bound to the next block number and get the weights of the transitions,
+// the proposer weights contract only being read when running the consensus.
+func (sb *Backend) snapApplyProposerPolicy(snap *Snapshot) {
+	next := new(big.Int).SetUint64(snap.Number + 1)
+	id, ok := sb.config.TransitionProposerPolicy(next)
+	if !ok {
+		return
+	}
+	policy := istanbul.NewProposerPolicyByIdAndSortFunc(id, istanbul.ValidatorSortByByte())
+	if id != istanbul.Weighted {
+		snap.ValSet = validator.NewSet(snap.validators(), policy)
+		return
+	}
+	snap.ValSet = validator.NewWeightedSet(snap.validators(), policy, sb.config.GetConfig(next).ProposerWeights, next.Uint64())
+}
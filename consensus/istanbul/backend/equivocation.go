@@ -0,0 +1,85 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/event"
+)
+
+const dbKeyEquivocationPrefix = "istanbul-equivocation"
+
+// equivocationKey = dbKeyEquivocationPrefix + sequence (uint64 big endian) +
+// round (uint64 big endian) + code (uint64 big endian) + validator
+func equivocationKey(evidence *istanbul.EquivocationEvidence) []byte {
+	key := make([]byte, len(dbKeyEquivocationPrefix)+24, len(dbKeyEquivocationPrefix)+24+common.AddressLength)
+	copy(key, dbKeyEquivocationPrefix)
+	binary.BigEndian.PutUint64(key[len(dbKeyEquivocationPrefix):], evidence.Sequence)
+	binary.BigEndian.PutUint64(key[len(dbKeyEquivocationPrefix)+8:], evidence.Round)
+	binary.BigEndian.PutUint64(key[len(dbKeyEquivocationPrefix)+16:], evidence.Code)
+	return append(key, evidence.Validator[:]...)
+}
+
+// loadEquivocationEvidence loads the evidence recorded for the heights within the
+// given range, both ends included, ordered by height.
+func loadEquivocationEvidence(db ethdb.Iteratee, from, to uint64) ([]*istanbul.EquivocationEvidence, error) {
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, from)
+
+	it := db.NewIterator([]byte(dbKeyEquivocationPrefix), start)
+	defer it.Release()
+
+	evidences := []*istanbul.EquivocationEvidence{}
+	for it.Next() {
+		evidence := new(istanbul.EquivocationEvidence)
+		if err := json.Unmarshal(it.Value(), evidence); err != nil {
+			return nil, err
+		}
+		if evidence.Sequence > to {
+			break
+		}
+		evidences = append(evidences, evidence)
+	}
+	return evidences, it.Error()
+}
+
+// ReportEquivocation implements istanbul.Backend.ReportEquivocation
+func (sb *Backend) ReportEquivocation(evidence *istanbul.EquivocationEvidence) {
+	sb.logger.Warn("IBFT: validator equivocation detected", "validator", evidence.Validator, "code", evidence.Code, "sequence", evidence.Sequence, "round", evidence.Round)
+
+	blob, err := json.Marshal(evidence)
+	if err != nil {
+		sb.logger.Error("IBFT: failed to encode equivocation evidence", "err", err)
+		return
+	}
+	if err := sb.db.Put(equivocationKey(evidence), blob); err != nil {
+		sb.logger.Error("IBFT: failed to store equivocation evidence", "err", err)
+	}
+	// Deliver off the consensus loop, subscribers may be slow to receive
+	go sb.equivocationFeed.Send(evidence)
+}
+
+// SubscribeEquivocation subscribes to the evidence of validator equivocation as
+// it gets detected.
+func (sb *Backend) SubscribeEquivocation(ch chan<- *istanbul.EquivocationEvidence) event.Subscription {
+	return sb.equivocationScope.Track(sb.equivocationFeed.Subscribe(ch))
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	qbfttypes "github.com/electroneum/electroneum-sc/consensus/istanbul/types"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+func TestReportEquivocation(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+	defer engine.Close()
+
+	evidences := make(chan *istanbul.EquivocationEvidence, 10)
+	sub := engine.SubscribeEquivocation(evidences)
+	defer sub.Unsubscribe()
+
+	validator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	for _, sequence := range []uint64{7, 3, 5} {
+		engine.ReportEquivocation(&istanbul.EquivocationEvidence{
+			Validator: validator,
+			Code:      qbfttypes.CommitCode,
+			Sequence:  sequence,
+			First:     []byte{0x01},
+			Second:    []byte{0x02},
+		})
+		select {
+		case evidence := <-evidences:
+			if evidence.Sequence != sequence {
+				t.Errorf("evidence sequence mismatch: have %d, want %d", evidence.Sequence, sequence)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for evidence of height %d", sequence)
+		}
+	}
+
+	api := &API{chain: chain, backend: engine}
+	tests := []struct {
+		from, to *rpc.BlockNumber
+		want     []uint64
+	}{
+		{nil, nil, []uint64{}},
+		{nil, blockNumber(10), []uint64{3, 5, 7}},
+		{blockNumber(4), nil, []uint64{5, 7}},
+		{blockNumber(3), blockNumber(5), []uint64{3, 5}},
+		{blockNumber(8), blockNumber(10), []uint64{}},
+	}
+	for i, test := range tests {
+		have, err := api.GetEquivocationEvidence(test.from, test.to)
+		if err != nil {
+			t.Fatalf("test %d: failed to get evidence: %v", i, err)
+		}
+		if len(have) != len(test.want) {
+			t.Fatalf("test %d: evidence count mismatch: have %d, want %d", i, len(have), len(test.want))
+		}
+		for j, evidence := range have {
+			if evidence.Sequence != test.want[j] || evidence.Validator != validator {
+				t.Errorf("test %d: evidence %d mismatch: have %+v, want height %d", i, j, evidence, test.want[j])
+			}
+		}
+	}
+	// Ranges too long to load at once are rejected
+	if _, err := api.GetEquivocationEvidence(blockNumber(0), blockNumber(maxEquivocationBlocks)); err == nil {
+		t.Errorf("expected range exceeding %d blocks to be rejected", maxEquivocationBlocks)
+	}
+}
+
+func TestReportEquivocationSlowSubscriber(t *testing.T) {
+	_, engine := newBlockChain(1)
+	defer engine.Stop()
+	defer engine.Close()
+
+	// A subscriber never receiving must not hold up the reporting
+	sub := engine.SubscribeEquivocation(make(chan *istanbul.EquivocationEvidence))
+	defer sub.Unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		for sequence := uint64(1); sequence <= 3; sequence++ {
+			engine.ReportEquivocation(&istanbul.EquivocationEvidence{Code: qbfttypes.CommitCode, Sequence: sequence})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("reporting blocked on a slow subscriber")
+	}
+}
+
+func blockNumber(n int64) *rpc.BlockNumber {
+	number := rpc.BlockNumber(n)
+	return &number
+}
@@ -130,12 +130,44 @@ func (sb *Backend) SetBroadcaster(broadcaster consensus.Broadcaster) {
 	sb.broadcaster = broadcaster
 }
 
+// Broadcaster returns the broadcaster used to send messages to peers.
+func (sb *Backend) Broadcaster() consensus.Broadcaster {
+	return sb.broadcaster
+}
+
+// CurrentValidators returns the validators of the block following the chain head,
+// or nil if the engine isn't running. The set is shared by the callers until the
+// head changes, and must not be modified.
+func (sb *Backend) CurrentValidators() istanbul.ValidatorSet {
+	sb.coreMu.RLock()
+	defer sb.coreMu.RUnlock()
+	if !sb.coreStarted {
+		return nil
+	}
+	return sb.headValidatorSet(sb.currentBlock())
+}
+
+// headValidatorSet returns the validators of the block following the given head,
+// resolving them only when the head changed since the last call, as they are
+// looked up for every inbound message.
+func (sb *Backend) headValidatorSet(head *types.Block) istanbul.ValidatorSet {
+	sb.headValidatorsLock.Lock()
+	defer sb.headValidatorsLock.Unlock()
+
+	if sb.headValidators == nil || sb.headValidatorsHash != head.Hash() {
+		sb.headValidators = sb.getValidators(head.NumberU64(), head.Hash())
+		sb.headValidatorsHash = head.Hash()
+	}
+	return sb.headValidators
+}
+
 func (sb *Backend) NewChainHead() error {
 	sb.coreMu.RLock()
 	defer sb.coreMu.RUnlock()
 	if !sb.coreStarted {
 		return istanbul.ErrStoppedEngine
 	}
+	sb.headValidatorSet(sb.currentBlock())
 	go sb.istanbulEventMux.Post(istanbul.FinalCommittedEvent{})
 	return nil
 }
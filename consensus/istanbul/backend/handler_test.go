@@ -146,3 +146,30 @@ func buildArbitraryP2PNewBlockMessage(t *testing.T, invalidMsg bool) (*types.Blo
 	arbitraryP2PMessage := p2p.Msg{Code: 0x07, Size: uint32(size), Payload: bytes.NewReader(payload)}
 	return arbitraryBlock, arbitraryP2PMessage
 }
+
+func TestCurrentValidatorsCache(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	validators := engine.CurrentValidators()
+	if validators == nil || validators.Size() != 1 {
+		t.Fatalf("validators mismatch: have %v", validators)
+	}
+	if engine.CurrentValidators() != validators {
+		t.Errorf("validators resolved again for the same head")
+	}
+	// The single validator votes a candidate in, changing the set of the new head
+	candidate := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	engine.candidates[candidate] = true
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+	engine.NewChainHead()
+	if engine.headValidatorsHash != block.Hash() {
+		t.Errorf("validators not refreshed on new head")
+	}
+	if have := engine.CurrentValidators(); have.Size() != 2 {
+		t.Errorf("validators mismatch: have %v", have.List())
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	qbftengine "github.com/electroneum/electroneum-sc/consensus/istanbul/engine"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+func TestGetValidatorSetProof(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	// The single validator votes a candidate in with the first block
+	candidate := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	engine.candidates[candidate] = true
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+
+	api := &API{chain: chain, backend: engine}
+	proof, err := api.GetValidatorSetProof(nil, nil)
+	if err != nil {
+		t.Fatalf("failed to get proof: %v", err)
+	}
+	genesisValidators := []common.Address{engine.Address()}
+	if proof.Number != 0 || !reflect.DeepEqual(proof.Validators, genesisValidators) {
+		t.Errorf("proof start mismatch: have %d %v, want 0 %v", proof.Number, proof.Validators, genesisValidators)
+	}
+	if len(proof.Changes) != 1 || proof.Changes[0].Header.Hash() != block.Hash() {
+		t.Fatalf("proof changes mismatch: have %v", proof.Changes)
+	}
+	// The vote is tallied from the genesis checkpoint
+	if votes := proof.Changes[0].Votes; len(votes) != 1 || votes[0].Hash() != chain.Genesis().Hash() {
+		t.Fatalf("proof votes mismatch: have %v", votes)
+	}
+
+	// The proof must verify without access to the chain
+	verifier := qbftengine.NewEngine(engine.config, common.Address{}, nil)
+	have, err := verifier.VerifyValidatorSetChanges(genesisValidators, proof.Changes)
+	if err != nil {
+		t.Fatalf("failed to verify proof: %v", err)
+	}
+	want := validator.SortedAddresses(engine.getValidators(1, block.Hash()).List())
+	if !reflect.DeepEqual(have, want) || len(want) != 2 {
+		t.Errorf("validators mismatch: have %v, want %v", have, want)
+	}
+
+	// Ranges without changes yield an empty proof
+	from, to := rpc.BlockNumber(1), rpc.LatestBlockNumber
+	if proof, err = api.GetValidatorSetProof(&from, &to); err != nil {
+		t.Fatalf("failed to get proof: %v", err)
+	}
+	if proof.Number != 1 || len(proof.Changes) != 0 || !reflect.DeepEqual(proof.Validators, want) {
+		t.Errorf("proof mismatch: have %+v", proof)
+	}
+
+	// Ranges too long to prove at once are rejected
+	from, to = rpc.BlockNumber(0), rpc.BlockNumber(maxValidatorSetProofBlocks)
+	if _, err := api.GetValidatorSetProof(&from, &to); err == nil {
+		t.Errorf("expected range exceeding %d blocks to be rejected", maxValidatorSetProofBlocks)
+	}
+}
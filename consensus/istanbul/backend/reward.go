@@ -0,0 +1,134 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"math/big"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/params"
+)
+
+// rewardShare is the part of a block reward credited to a single account.
+type rewardShare struct {
+	address common.Address
+	amount  *big.Int
+}
+
+// blockRewardShares splits the block reward of the given header according to the
+// reward mode active at its block.
+//
+// The shares always add up to the full base block reward, so the emission
+// accounting holds in every mode. The only exception is a block without any
+// recipient (e.g. an empty coinbase in proposer mode), which is left unpaid as it
+// always was.
+func (sb *Backend) blockRewardShares(chain consensus.ChainHeaderReader, header *types.Header) ([]rewardShare, error) {
+	recipients, err := sb.blockRewardRecipients(chain, header)
+	if err != nil || len(recipients) == 0 {
+		return nil, err
+	}
+	reward, err := sb.blockReward(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	amount, remainder := new(big.Int).DivMod(reward, big.NewInt(int64(len(recipients))), new(big.Int))
+
+	shares := make([]rewardShare, len(recipients))
+	for i, recipient := range recipients {
+		shares[i] = rewardShare{address: recipient, amount: new(big.Int).Set(amount)}
+	}
+	// The indivisible remainder goes to the proposer if it is a recipient, or to
+	// the first recipient otherwise, so nothing is lost
+	receiver := 0
+	for i, recipient := range recipients {
+		if recipient == header.Coinbase {
+			receiver = i
+			break
+		}
+	}
+	shares[receiver].amount.Add(shares[receiver].amount, remainder)
+
+	return shares, nil
+}
+
+// creditedRewardShares returns the block reward shares credited for the given
+// header: the split of blockRewardShares, or the whole base block reward to the
+// proposer if the split can't be resolved.
+func (sb *Backend) creditedRewardShares(chain consensus.ChainHeaderReader, header *types.Header) ([]rewardShare, error) {
+	shares, err := sb.blockRewardShares(chain, header)
+	if err == nil {
+		return shares, nil
+	}
+	sb.logger.Error("IBFT: failed to split block reward, paying the proposer", "number", header.Number, "err", err)
+	return sb.proposerRewardShares(chain, header)
+}
+
+// proposerRewardShares credits the whole base block reward of the given header to
+// its proposer, as done before the reward modes were introduced.
+func (sb *Backend) proposerRewardShares(chain consensus.ChainHeaderReader, header *types.Header) ([]rewardShare, error) {
+	if header.Coinbase == (common.Address{}) {
+		return nil, nil
+	}
+	reward, err := sb.blockReward(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	return []rewardShare{{address: header.Coinbase, amount: reward}}, nil
+}
+
+// blockReward returns the base block reward of the given header, failing instead
+// of panicking like GetBaseBlockReward if the emission can't be resolved.
+func (sb *Backend) blockReward(chain consensus.ChainHeaderReader, header *types.Header) (*big.Int, error) {
+	emission, err := sb.emission(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	return baseBlockReward(chain.Config(), header.Number, emission.CirculatingSupply), nil
+}
+
+// blockRewardRecipients returns the accounts the block reward of the given header
+// is split evenly between.
+func (sb *Backend) blockRewardRecipients(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	config := sb.config.GetConfig(header.Number)
+
+	switch config.BlockRewardMode {
+	case params.ValidatorsRewardMode:
+		snap, err := sb.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+		if err != nil {
+			return nil, err
+		}
+		if validators := snap.validators(); len(validators) > 0 {
+			return validators, nil
+		}
+
+	case params.BeneficiaryRewardMode:
+		beneficiary, ok := config.BlockRewardBeneficiaries[header.Coinbase]
+		if !ok || beneficiary == (common.Address{}) {
+			beneficiary = config.BlockRewardTreasury
+		}
+		if beneficiary != (common.Address{}) {
+			return []common.Address{beneficiary}, nil
+		}
+	}
+
+	if header.Coinbase == (common.Address{}) {
+		return nil, nil
+	}
+	return []common.Address{header.Coinbase}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/params"
+)
+
+func TestBlockRewardShares(t *testing.T) {
+	chain, engine := newBlockChain(3)
+	defer engine.Stop()
+
+	var (
+		treasury    = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		beneficiary = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+		validators  = engine.getValidators(0, chain.Genesis().Hash())
+		proposer    = validators.GetByIndex(1).Address()
+	)
+	header := makeHeader(chain.Genesis(), engine.config)
+	header.Coinbase = proposer
+	reward := engine.GetBaseBlockReward(chain, header, nil)
+
+	tests := []struct {
+		transition params.Transition
+		want       map[common.Address]*big.Int
+	}{
+		{
+			params.Transition{},
+			map[common.Address]*big.Int{proposer: reward},
+		},
+		{
+			params.Transition{BlockRewardMode: params.ProposerRewardMode},
+			map[common.Address]*big.Int{proposer: reward},
+		},
+		{
+			params.Transition{BlockRewardMode: params.ValidatorsRewardMode},
+			map[common.Address]*big.Int{
+				validators.GetByIndex(0).Address(): new(big.Int).Div(reward, big.NewInt(3)),
+				validators.GetByIndex(1).Address(): new(big.Int).Add(new(big.Int).Div(reward, big.NewInt(3)), new(big.Int).Mod(reward, big.NewInt(3))),
+				validators.GetByIndex(2).Address(): new(big.Int).Div(reward, big.NewInt(3)),
+			},
+		},
+		{
+			params.Transition{BlockRewardMode: params.BeneficiaryRewardMode, BlockRewardTreasury: treasury},
+			map[common.Address]*big.Int{treasury: reward},
+		},
+		{
+			params.Transition{
+				BlockRewardMode:          params.BeneficiaryRewardMode,
+				BlockRewardTreasury:      treasury,
+				BlockRewardBeneficiaries: map[common.Address]common.Address{proposer: beneficiary},
+			},
+			map[common.Address]*big.Int{beneficiary: reward},
+		},
+	}
+	for i, test := range tests {
+		test.transition.Block = big.NewInt(0)
+		engine.config.Transitions = []params.Transition{test.transition}
+
+		shares, err := engine.blockRewardShares(chain, header)
+		if err != nil {
+			t.Fatalf("test %d: failed to get reward shares: %v", i, err)
+		}
+		if len(shares) != len(test.want) {
+			t.Errorf("test %d: shares mismatch: have %d, want %d", i, len(shares), len(test.want))
+		}
+		total := new(big.Int)
+		for _, share := range shares {
+			if want := test.want[share.address]; want == nil || want.Cmp(share.amount) != 0 {
+				t.Errorf("test %d: share of %v mismatch: have %v, want %v", i, share.address, share.amount, want)
+			}
+			total.Add(total, share.amount)
+		}
+		// The distributed reward must match what the emission accounts for
+		if total.Cmp(reward) != 0 {
+			t.Errorf("test %d: total reward mismatch: have %v, want %v", i, total, reward)
+		}
+	}
+}
+
+func TestFinalizeUnresolvedReward(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	engine.config.Transitions = []params.Transition{{Block: big.NewInt(0), BlockRewardMode: params.ValidatorsRewardMode}}
+
+	// A header with an unknown parent has neither validators nor an emission to
+	// resolve the reward from, which must not take the node down
+	header := makeHeader(chain.Genesis(), engine.config)
+	header.Number = big.NewInt(2)
+	header.ParentHash = common.HexToHash("0x01")
+	header.Coinbase = engine.Address()
+
+	statedb, err := chain.StateAt(chain.Genesis().Root())
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	engine.Finalize(chain, header, statedb, nil, nil)
+	if balance := statedb.GetBalance(header.Coinbase); balance.Sign() != 0 {
+		t.Errorf("proposer balance mismatch: have %v, want 0", balance)
+	}
+}
+
+// Tests that the block rewards reported for tracing are the ones credited by
+// Finalize, including when the split falls back to paying the proposer.
+func TestBlockRewardsProposerFallback(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer engine.Stop()
+
+	engine.config.Transitions = []params.Transition{{Block: big.NewInt(0), BlockRewardMode: params.ValidatorsRewardMode}}
+
+	// The emission of the unknown parent is known, its validators are not
+	header := makeHeader(chain.Genesis(), engine.config)
+	header.Number = big.NewInt(2)
+	header.ParentHash = common.HexToHash("0x01")
+	header.Coinbase = engine.Address()
+	engine.recentsEmission.Add(header.ParentHash, newEmission(1, header.ParentHash, chain.Config().GenesisETN))
+
+	statedb, err := chain.StateAt(chain.Genesis().Root())
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	engine.Finalize(chain, header, statedb, nil, nil)
+	credited := statedb.GetBalance(header.Coinbase)
+	if credited.Sign() == 0 {
+		t.Fatalf("proposer not paid on a failed reward split")
+	}
+	rewards, err := engine.BlockRewards(chain, header)
+	if err != nil {
+		t.Fatalf("failed to retrieve block rewards: %v", err)
+	}
+	if len(rewards) != 1 || rewards[0].Beneficiary != header.Coinbase || rewards[0].Amount.Cmp(credited) != 0 {
+		t.Errorf("block rewards mismatch: have %v, want %v to %x", rewards, credited, header.Coinbase)
+	}
+}
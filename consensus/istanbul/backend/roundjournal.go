@@ -0,0 +1,223 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/log"
+)
+
+const (
+	dbKeyRoundHistoryPrefix = "istanbul-rounds"
+
+	// roundJournalLimit is the number of heights kept in the round journal, older
+	// heights are pruned as new ones get journaled
+	roundJournalLimit = 50000
+
+	// roundJournalFlushInterval is how often the entries journaled in memory get
+	// written to the database
+	roundJournalFlushInterval = time.Second
+
+	// roundRecordMessageLimit caps the messages journaled for a single round, so a
+	// misbehaving validator can't grow the history of a height without bound
+	roundRecordMessageLimit = 1024
+)
+
+// roundJournal is the on-disk istanbul.RoundJournal, keeping the history of every
+// height in a single database entry. Entries are collected in memory and written
+// in batches by a background loop, keeping the database off the consensus loop.
+type roundJournal struct {
+	db     ethdb.Database
+	logger log.Logger
+
+	pending map[uint64]*istanbul.RoundHistory // Entries journaled since the last flush
+	lock    sync.Mutex                        // Protects pending
+
+	latest    uint64     // Highest height flushed, older heights are pruned relative to it
+	flushLock sync.Mutex // Serialises the flushes
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newRoundJournal(db ethdb.Database, logger log.Logger) *roundJournal {
+	j := &roundJournal{
+		db:      db,
+		logger:  logger,
+		pending: make(map[uint64]*istanbul.RoundHistory),
+		quit:    make(chan struct{}),
+	}
+	j.wg.Add(1)
+	go j.loop()
+	return j
+}
+
+// roundHistoryKey = dbKeyRoundHistoryPrefix + sequence (uint64 big endian)
+func roundHistoryKey(sequence uint64) []byte {
+	key := make([]byte, len(dbKeyRoundHistoryPrefix)+8)
+	copy(key, dbKeyRoundHistoryPrefix)
+	binary.BigEndian.PutUint64(key[len(dbKeyRoundHistoryPrefix):], sequence)
+	return key
+}
+
+// loadRoundHistory loads the journaled history of a height from the database.
+func loadRoundHistory(db ethdb.KeyValueReader, sequence uint64) (*istanbul.RoundHistory, error) {
+	blob, err := db.Get(roundHistoryKey(sequence))
+	if err != nil {
+		return nil, err
+	}
+	history := new(istanbul.RoundHistory)
+	if err := json.Unmarshal(blob, history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// JournalMessage implements istanbul.RoundJournal.JournalMessage
+func (j *roundJournal) JournalMessage(sequence uint64, round uint64, msg *istanbul.RoundMessage) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	record := j.record(sequence, round)
+	if len(record.Messages) < roundRecordMessageLimit {
+		record.Messages = append(record.Messages, msg)
+	}
+}
+
+// JournalTimeout implements istanbul.RoundJournal.JournalTimeout
+func (j *roundJournal) JournalTimeout(sequence uint64, round uint64, at time.Time) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	record := j.record(sequence, round)
+	record.Timeouts = append(record.Timeouts, at)
+}
+
+// record returns the pending record of the given round, creating it if needed. The
+// caller must hold the lock.
+func (j *roundJournal) record(sequence uint64, round uint64) *istanbul.RoundRecord {
+	history, ok := j.pending[sequence]
+	if !ok {
+		history = &istanbul.RoundHistory{Sequence: sequence, Rounds: []*istanbul.RoundRecord{}}
+		j.pending[sequence] = history
+	}
+	return history.Round(round)
+}
+
+// loop periodically flushes the pending entries until the journal is closed.
+func (j *roundJournal) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(roundJournalFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			j.flush()
+		case <-j.quit:
+			j.flush()
+			return
+		}
+	}
+}
+
+// close stops the background loop, flushing the entries still pending.
+func (j *roundJournal) close() {
+	close(j.quit)
+	j.wg.Wait()
+}
+
+// flush merges the pending entries into the stored history of their heights and
+// writes them back in a single batch. Failures are only logged, journaling never
+// interferes with the consensus.
+func (j *roundJournal) flush() {
+	j.flushLock.Lock()
+	defer j.flushLock.Unlock()
+
+	j.lock.Lock()
+	pending := j.pending
+	j.pending = make(map[uint64]*istanbul.RoundHistory)
+	j.lock.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	var (
+		batch  = j.db.NewBatch()
+		latest = j.latest
+	)
+	for sequence, entries := range pending {
+		history, err := loadRoundHistory(j.db, sequence)
+		if err != nil {
+			history = &istanbul.RoundHistory{Sequence: sequence, Rounds: []*istanbul.RoundRecord{}}
+		}
+		for _, entry := range entries.Rounds {
+			record := history.Round(entry.Round)
+			if room := roundRecordMessageLimit - len(record.Messages); room > 0 {
+				if len(entry.Messages) > room {
+					entry.Messages = entry.Messages[:room]
+				}
+				record.Messages = append(record.Messages, entry.Messages...)
+			}
+			record.Timeouts = append(record.Timeouts, entry.Timeouts...)
+		}
+		blob, err := json.Marshal(history)
+		if err != nil {
+			j.logger.Error("IBFT: failed to encode round history", "sequence", sequence, "err", err)
+			continue
+		}
+		batch.Put(roundHistoryKey(sequence), blob)
+		if sequence > latest {
+			latest = sequence
+		}
+	}
+	if err := batch.Write(); err != nil {
+		j.logger.Error("IBFT: failed to store round history", "err", err)
+		return
+	}
+	if latest > j.latest {
+		j.latest = latest
+		j.prune()
+	}
+}
+
+// prune deletes the history of the heights that fell out of the journal limit.
+func (j *roundJournal) prune() {
+	if j.latest < roundJournalLimit {
+		return
+	}
+	limit := roundHistoryKey(j.latest - roundJournalLimit + 1)
+
+	it := j.db.NewIterator([]byte(dbKeyRoundHistoryPrefix), nil)
+	defer it.Release()
+
+	batch := j.db.NewBatch()
+	for it.Next() && bytes.Compare(it.Key(), limit) < 0 {
+		batch.Delete(it.Key())
+	}
+	if err := batch.Write(); err != nil {
+		j.logger.Error("IBFT: failed to prune round history", "err", err)
+	}
+}
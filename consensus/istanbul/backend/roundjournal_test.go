@@ -0,0 +1,141 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/testutils"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+func TestRoundJournal(t *testing.T) {
+	journal := newRoundJournal(rawdb.NewMemoryDatabase(), log.New())
+	defer journal.close()
+
+	var (
+		source  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		timeout = time.Unix(1700000000, 0).UTC()
+	)
+	journal.JournalMessage(5, 2, &istanbul.RoundMessage{Code: "ROUND-CHANGE", Source: source})
+	journal.JournalTimeout(5, 1, timeout)
+	journal.JournalMessage(5, 0, &istanbul.RoundMessage{Code: "PREPREPARE", Source: source})
+
+	// Nothing is written until the entries get flushed
+	if _, err := loadRoundHistory(journal.db, 5); err == nil {
+		t.Fatalf("round history written before flushing")
+	}
+	journal.flush()
+	history, err := loadRoundHistory(journal.db, 5)
+	if err != nil {
+		t.Fatalf("failed to load round history: %v", err)
+	}
+	if len(history.Rounds) != 3 {
+		t.Fatalf("rounds mismatch: have %d, want 3", len(history.Rounds))
+	}
+	for i, record := range history.Rounds {
+		if record.Round != uint64(i) {
+			t.Errorf("record %d: round mismatch: have %d, want %d", i, record.Round, i)
+		}
+	}
+	if have := history.Rounds[1].Timeouts; len(have) != 1 || !have[0].Equal(timeout) {
+		t.Errorf("timeouts mismatch: have %v, want [%v]", have, timeout)
+	}
+	if have := history.Rounds[2].Messages; len(have) != 1 || have[0].Code != "ROUND-CHANGE" || have[0].Source != source {
+		t.Errorf("messages mismatch: have %v", have)
+	}
+
+	// Heights falling out of the limit get pruned
+	journal.JournalTimeout(5+roundJournalLimit, 0, timeout)
+	journal.flush()
+	if _, err := loadRoundHistory(journal.db, 5); err == nil {
+		t.Errorf("expected height 5 to be pruned")
+	}
+	if _, err := loadRoundHistory(journal.db, 5+roundJournalLimit); err != nil {
+		t.Errorf("failed to load latest height: %v", err)
+	}
+}
+
+func TestRoundJournalMessageLimit(t *testing.T) {
+	journal := newRoundJournal(rawdb.NewMemoryDatabase(), log.New())
+	defer journal.close()
+
+	// Messages beyond the limit are dropped, both before and after flushing
+	for i := 0; i < roundRecordMessageLimit+10; i++ {
+		journal.JournalMessage(1, 0, &istanbul.RoundMessage{Code: "PREPARE"})
+	}
+	journal.flush()
+	journal.JournalMessage(1, 0, &istanbul.RoundMessage{Code: "COMMIT"})
+	journal.flush()
+
+	history, err := loadRoundHistory(journal.db, 1)
+	if err != nil {
+		t.Fatalf("failed to load round history: %v", err)
+	}
+	if have := len(history.Rounds[0].Messages); have != roundRecordMessageLimit {
+		t.Errorf("message count mismatch: have %d, want %d", have, roundRecordMessageLimit)
+	}
+}
+
+func TestGetRoundHistory(t *testing.T) {
+	genesis, nodeKeys := testutils.GenesisAndKeys(1)
+	config := copyConfig(istanbul.DefaultConfig)
+	config.RoundJournal = true
+	chain, engine := newBlockchainFromConfig(genesis, nodeKeys, config)
+	defer engine.Stop()
+
+	block := makeBlock(chain, engine, chain.Genesis())
+
+	api := &API{chain: chain, backend: engine}
+	history, err := api.GetRoundHistory(rpc.BlockNumber(1))
+	if err != nil {
+		t.Fatalf("failed to get round history: %v", err)
+	}
+	if history == nil || history.Sequence != 1 || len(history.Rounds) != 1 {
+		t.Fatalf("history mismatch: have %+v", history)
+	}
+	// A single validator receives its own messages for every step of round 0
+	seen := make(map[string]bool)
+	for _, msg := range history.Rounds[0].Messages {
+		if msg.Source != engine.Address() {
+			t.Errorf("%s source mismatch: have %v, want %v", msg.Code, msg.Source, engine.Address())
+		}
+		if msg.Digest == nil || *msg.Digest != block.Hash() {
+			t.Errorf("%s digest mismatch: have %v, want %v", msg.Code, msg.Digest, block.Hash())
+		}
+		seen[msg.Code] = true
+	}
+	for _, code := range []string{"PREPREPARE", "PREPARE", "COMMIT"} {
+		if !seen[code] {
+			t.Errorf("missing %s message", code)
+		}
+	}
+
+	// Heights never journaled are reported empty, or as an error without a journal
+	if history, err := api.GetRoundHistory(rpc.BlockNumber(100)); history != nil || err != nil {
+		t.Errorf("unexpected history of unknown height: %v, %v", history, err)
+	}
+	engine.roundJournal = nil
+	if _, err := api.GetRoundHistory(rpc.BlockNumber(100)); err != errRoundJournalDisabled {
+		t.Errorf("error mismatch: have %v, want %v", err, errRoundJournalDisabled)
+	}
+}
@@ -183,9 +183,11 @@ type snapshotJSON struct {
 	// for validator set
 	Validators []common.Address          `json:"validators"`
 	Policy     istanbul.ProposerPolicyId `json:"policy"`
+	Weights    map[common.Address]uint64 `json:"weights,omitempty"`
 }
 
 func (s *Snapshot) toJSONStruct() *snapshotJSON {
+	weights, _ := validator.Weights(s.ValSet)
 	return &snapshotJSON{
 		Epoch:      s.Epoch,
 		Number:     s.Number,
@@ -194,6 +196,7 @@ func (s *Snapshot) toJSONStruct() *snapshotJSON {
 		Tally:      s.Tally,
 		Validators: s.validators(),
 		Policy:     s.ValSet.Policy().Id,
+		Weights:    weights,
 	}
 }
 
@@ -211,7 +214,11 @@ func (s *Snapshot) UnmarshalJSON(b []byte) error {
 	s.Tally = j.Tally
 
 	pp := istanbul.NewProposerPolicyByIdAndSortFunc(j.Policy, istanbul.ValidatorSortByByte())
-	s.ValSet = validator.NewSet(j.Validators, pp)
+	if j.Policy == istanbul.Weighted {
+		s.ValSet = validator.NewWeightedSet(j.Validators, pp, j.Weights, j.Number+1)
+	} else {
+		s.ValSet = validator.NewSet(j.Validators, pp)
+	}
 	return nil
 }
 
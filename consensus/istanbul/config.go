@@ -30,11 +30,12 @@ type ProposerPolicyId uint64
 const (
 	RoundRobin ProposerPolicyId = iota
 	Sticky
+	Weighted
 )
 
 // ProposerPolicy represents the Validator Proposer Policy
 type ProposerPolicy struct {
-	Id         ProposerPolicyId    // Could be RoundRobin or Sticky
+	Id         ProposerPolicyId    // Could be RoundRobin, Sticky or Weighted
 	By         ValidatorSortByFunc // func that defines how the ValidatorSet should be sorted
 	registry   []ValidatorSet      // Holds the ValidatorSet for a given block height
 	registryMU *sync.Mutex         // Mutex to lock access to changes to Registry
@@ -120,14 +121,23 @@ func (p *ProposerPolicy) ClearRegistry() {
 }
 
 type Config struct {
-	RequestTimeoutSeconds              uint64              `toml:",omitempty"` // The timeout for each Istanbul round in seconds.
-	MaxRequestTimeoutSeconds           uint64              `toml:",omitempty"` // Max request timeout for each Istanbul round in seconds.
-	BlockPeriod                        uint64              `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second
-	ProposerPolicy                     *ProposerPolicy     `toml:",omitempty"` // The policy for proposer selection
-	Epoch                              uint64              `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
-	AllowedFutureBlockTime             uint64              `toml:",omitempty"` // Max time (in seconds) from current time allowed for blocks, before they're considered future blocks
-	Transitions                        []params.Transition // Transition data
-	PriorityTransactorsContractAddress common.Address      // PriorityTransactors contract address
+	RequestTimeoutSeconds              uint64                            `toml:",omitempty"` // The timeout for each Istanbul round in seconds.
+	MaxRequestTimeoutSeconds           uint64                            `toml:",omitempty"` // Max request timeout for each Istanbul round in seconds.
+	BlockPeriod                        uint64                            `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second
+	ProposerPolicy                     *ProposerPolicy                   `toml:",omitempty"` // The policy for proposer selection
+	Epoch                              uint64                            `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
+	AllowedFutureBlockTime             uint64                            `toml:",omitempty"` // Max time (in seconds) from current time allowed for blocks, before they're considered future blocks
+	Transitions                        []params.Transition               // Transition data
+	PriorityTransactorsContractAddress common.Address                    // PriorityTransactors contract address
+	ValidatorSelectionMode             string                            // Where the validator set is read from, see params.BlockHeaderMode and params.ContractMode
+	ValidatorContract                  common.Address                    // Validator set contract address, used when ValidatorSelectionMode is params.ContractMode
+	BlockRewardMode                    string                            // How the block reward is distributed, see params.ProposerRewardMode
+	BlockRewardBeneficiaries           map[common.Address]common.Address // Validator to beneficiary mapping, used in params.BeneficiaryRewardMode
+	BlockRewardTreasury                common.Address                    // Beneficiary of validators without a mapping, used in params.BeneficiaryRewardMode
+	ProposerPolicyMode                 string                            // Proposer policy selected by the transitions, see params.WeightedProposerPolicy
+	ProposerWeights                    map[common.Address]uint64         // Validator to proposer weight mapping, used by params.WeightedProposerPolicy
+	ProposerWeightsContract            common.Address                    // Proposer weights contract address, used by params.WeightedProposerPolicy
+	RoundJournal                       bool                              `toml:",omitempty"` // Whether to record the messages and timeouts of each consensus round on disk
 }
 
 var DefaultConfig = &Config{
@@ -157,6 +167,52 @@ func (c Config) GetConfig(blockNumber *big.Int) Config {
 		if c.Transitions[i].AllowedFutureBlockTime != 0 {
 			newConfig.AllowedFutureBlockTime = c.Transitions[i].AllowedFutureBlockTime
 		}
+		if c.Transitions[i].ValidatorSelectionMode != "" {
+			newConfig.ValidatorSelectionMode = c.Transitions[i].ValidatorSelectionMode
+		}
+		if c.Transitions[i].ValidatorContractAddress != (common.Address{}) {
+			newConfig.ValidatorContract = c.Transitions[i].ValidatorContractAddress
+		}
+		if c.Transitions[i].BlockRewardMode != "" {
+			newConfig.BlockRewardMode = c.Transitions[i].BlockRewardMode
+		}
+		if c.Transitions[i].BlockRewardBeneficiaries != nil {
+			newConfig.BlockRewardBeneficiaries = c.Transitions[i].BlockRewardBeneficiaries
+		}
+		if c.Transitions[i].BlockRewardTreasury != (common.Address{}) {
+			newConfig.BlockRewardTreasury = c.Transitions[i].BlockRewardTreasury
+		}
+		if c.Transitions[i].ProposerPolicy != "" {
+			newConfig.ProposerPolicyMode = c.Transitions[i].ProposerPolicy
+		}
+		if c.Transitions[i].ProposerWeights != nil {
+			newConfig.ProposerWeights = c.Transitions[i].ProposerWeights
+		}
+		if c.Transitions[i].ProposerWeightsContractAddress != (common.Address{}) {
+			newConfig.ProposerWeightsContract = c.Transitions[i].ProposerWeightsContractAddress
+		}
 	}
 	return newConfig
 }
+
+// IsValidatorContractMode returns whether the validator set at the given block
+// is read from the validator contract instead of the header votes. The contract
+// set is carried by the epoch checkpoint headers, and in force after them.
+func (c Config) IsValidatorContractMode(blockNumber *big.Int) bool {
+	cfg := c.GetConfig(blockNumber)
+	return cfg.ValidatorSelectionMode == params.ContractMode && cfg.ValidatorContract != (common.Address{})
+}
+
+// TransitionProposerPolicy returns the proposer policy selected by the transitions
+// for the given block, if any.
+func (c Config) TransitionProposerPolicy(blockNumber *big.Int) (ProposerPolicyId, bool) {
+	switch c.GetConfig(blockNumber).ProposerPolicyMode {
+	case params.RoundRobinProposerPolicy:
+		return RoundRobin, true
+	case params.StickyProposerPolicy:
+		return Sticky, true
+	case params.WeightedProposerPolicy:
+		return Weighted, true
+	}
+	return RoundRobin, false
+}
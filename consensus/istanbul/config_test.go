@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/params"
 	"github.com/naoina/toml"
 	"github.com/stretchr/testify/assert"
@@ -90,3 +91,66 @@ func TestGetConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestIsValidatorContractMode(t *testing.T) {
+	config := *DefaultConfig
+	config.Transitions = []params.Transition{{
+		Block:                    big.NewInt(5),
+		ValidatorSelectionMode:   params.ContractMode,
+		ValidatorContractAddress: common.Address{0x1},
+	}, {
+		Block:                  big.NewInt(10),
+		ValidatorSelectionMode: params.BlockHeaderMode,
+	}, {
+		Block:                  big.NewInt(15),
+		ValidatorSelectionMode: params.ContractMode,
+	}}
+
+	tests := []struct {
+		blockNumber int64
+		want        bool
+	}{
+		{0, false},
+		{5, true},
+		{9, true},
+		{10, false},
+		{15, true},
+		{100, true},
+	}
+	for _, test := range tests {
+		if have := config.IsValidatorContractMode(big.NewInt(test.blockNumber)); have != test.want {
+			t.Errorf("block %d: contract mode mismatch: have %v, want %v", test.blockNumber, have, test.want)
+		}
+	}
+}
+
+func TestTransitionProposerPolicy(t *testing.T) {
+	config := *DefaultConfig
+	config.Transitions = []params.Transition{{
+		Block:          big.NewInt(5),
+		ProposerPolicy: params.WeightedProposerPolicy,
+	}, {
+		Block:       big.NewInt(10),
+		EpochLength: 100,
+	}, {
+		Block:          big.NewInt(15),
+		ProposerPolicy: params.StickyProposerPolicy,
+	}}
+
+	tests := []struct {
+		blockNumber int64
+		want        ProposerPolicyId
+		wantOk      bool
+	}{
+		{0, RoundRobin, false},
+		{5, Weighted, true},
+		{12, Weighted, true},
+		{15, Sticky, true},
+	}
+	for _, test := range tests {
+		have, ok := config.TransitionProposerPolicy(big.NewInt(test.blockNumber))
+		if have != test.want || ok != test.wantOk {
+			t.Errorf("block %d: proposer policy mismatch: have %v (%v), want %v (%v)", test.blockNumber, have, ok, test.want, test.wantOk)
+		}
+	}
+}
@@ -176,6 +176,24 @@ func (c *core) withinBacklogFutureWindow(msgCode uint64, view istanbul.View) boo
 	return true
 }
 
+// withinRecordingWindow reports whether a received message is worth recording in
+// the round journal and the equivocation checks: it must be signed by a current
+// validator and be about the previous, the current or a near future height, within
+// the backlog round window.
+func (c *core) withinRecordingWindow(m qbfttypes.QBFTMessage) bool {
+	if c.current == nil || !c.isValidatorAddress(m.Source()) {
+		return false
+	}
+	var (
+		view     = m.View()
+		cur      = c.currentView()
+		minSeq   = new(big.Int).Sub(cur.Sequence, common.Big1)
+		maxSeq   = new(big.Int).Add(cur.Sequence, new(big.Int).SetUint64(MaxFutureSequenceGap))
+		maxRound = new(big.Int).Add(cur.Round, new(big.Int).SetUint64(MaxFutureRoundGap))
+	)
+	return view.Sequence.Cmp(minSeq) >= 0 && view.Sequence.Cmp(maxSeq) <= 0 && view.Round.Cmp(maxRound) <= 0
+}
+
 // addToBacklog stores a future message for later processing, subject to
 // validator verification, future window limits, and capacity caps.
 func (c *core) addToBacklog(msg qbfttypes.QBFTMessage) {
@@ -54,6 +54,7 @@ func New(backend istanbul.Backend, config *istanbul.Config) istanbul.Core {
 		pendingRequestsMu:  new(sync.Mutex),
 		consensusTimestamp: time.Time{},
 		currentMutex:       new(sync.Mutex),
+		signedMessages:     make(map[signedMessageKey]*signedMessage),
 	}
 
 	c.validateFn = c.checkValidatorSignature
@@ -95,6 +96,11 @@ type core struct {
 	pendingRequestsMu *sync.Mutex
 
 	consensusTimestamp time.Time
+
+	// first PRE-PREPARE, PREPARE and COMMIT signed by each validator, to detect
+	// equivocation
+	signedMessages map[signedMessageKey]*signedMessage
+	signedView     istanbul.View // View the signed messages were last pruned at
 }
 
 func (c *core) currentView() *istanbul.View {
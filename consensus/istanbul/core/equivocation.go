@@ -0,0 +1,161 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	qbfttypes "github.com/electroneum/electroneum-sc/consensus/istanbul/types"
+	"github.com/electroneum/electroneum-sc/metrics"
+)
+
+var (
+	equivocationMeter = metrics.NewRegisteredMeter("consensus/istanbul/qbft/core/equivocation", nil)
+
+	// errInvalidEvidence is returned when equivocation evidence doesn't prove
+	// a validator signed conflicting messages
+	errInvalidEvidence = errors.New("invalid equivocation evidence")
+)
+
+// signedMessageKey identifies the messages a validator may only sign once.
+type signedMessageKey struct {
+	sequence uint64
+	round    uint64
+	code     uint64
+	source   common.Address
+}
+
+// signedMessage is the first message received for a signedMessageKey.
+type signedMessage struct {
+	digest   common.Hash
+	data     []byte
+	reported bool
+}
+
+// messageDigest returns the proposal a PRE-PREPARE, PREPARE or COMMIT is about.
+// Other messages can't equivocate.
+func messageDigest(m qbfttypes.QBFTMessage) (common.Hash, bool) {
+	switch m := m.(type) {
+	case *qbfttypes.Preprepare:
+		return m.Proposal.Hash(), true
+	case *qbfttypes.Prepare:
+		return m.Digest, true
+	case *qbfttypes.Commit:
+		return m.Digest, true
+	}
+	return common.Hash{}, false
+}
+
+// checkEquivocation compares a message, whose signature has already been verified,
+// with the ones previously received from the same validator for the same height,
+// round and type, reporting the validator to the backend if they conflict. Only
+// the messages of validators within the recording window are tracked.
+func (c *core) checkEquivocation(m qbfttypes.QBFTMessage, data []byte) {
+	digest, ok := messageDigest(m)
+	if !ok || !c.withinRecordingWindow(m) {
+		return
+	}
+	c.pruneSignedMessages()
+
+	view := m.View()
+	key := signedMessageKey{
+		sequence: view.Sequence.Uint64(),
+		round:    view.Round.Uint64(),
+		code:     m.Code(),
+		source:   m.Source(),
+	}
+	if key.sequence < c.signedView.Sequence.Uint64() {
+		return
+	}
+	first, ok := c.signedMessages[key]
+	if !ok {
+		c.signedMessages[key] = &signedMessage{digest: digest, data: data}
+		return
+	}
+	if first.digest == digest || first.reported {
+		return
+	}
+	first.reported = true
+	equivocationMeter.Mark(1)
+
+	c.currentLogger(false, m).Warn("IBFT: validator signed conflicting messages", "first", first.digest, "second", digest)
+	c.backend.ReportEquivocation(&istanbul.EquivocationEvidence{
+		Validator: key.source,
+		Code:      key.code,
+		Sequence:  key.sequence,
+		Round:     key.round,
+		First:     common.CopyBytes(first.data),
+		Second:    common.CopyBytes(data),
+		Detected:  time.Now(),
+	})
+}
+
+// pruneSignedMessages forgets about the heights already committed, along with the
+// rounds of the current height that fell behind the backlog round window.
+func (c *core) pruneSignedMessages() {
+	var (
+		sequence = c.current.Sequence().Uint64()
+		round    = c.current.Round().Uint64()
+	)
+	if c.signedView.Sequence != nil && c.signedView.Sequence.Uint64() == sequence && c.signedView.Round.Uint64() == round {
+		return
+	}
+	c.signedView = istanbul.View{Sequence: new(big.Int).SetUint64(sequence), Round: new(big.Int).SetUint64(round)}
+	for key := range c.signedMessages {
+		if key.sequence < sequence || (key.sequence == sequence && key.round+MaxFutureRoundGap < round) {
+			delete(c.signedMessages, key)
+		}
+	}
+}
+
+// VerifyEquivocationEvidence checks that both messages of the evidence are signed
+// by the accused validator for the height, round and type of the evidence, while
+// being about different proposals.
+func VerifyEquivocationEvidence(evidence *istanbul.EquivocationEvidence) error {
+	var digests [2]common.Hash
+	for i, data := range [][]byte{evidence.First, evidence.Second} {
+		m, err := qbfttypes.Decode(evidence.Code, data)
+		if err != nil {
+			return err
+		}
+		payload, err := m.EncodePayloadForSigning()
+		if err != nil {
+			return err
+		}
+		signer, err := istanbul.GetSignatureAddress(payload, m.Signature())
+		if err != nil {
+			return err
+		}
+		view := m.View()
+		if signer != evidence.Validator || view.Sequence.Uint64() != evidence.Sequence || view.Round.Uint64() != evidence.Round {
+			return errInvalidEvidence
+		}
+		digest, ok := messageDigest(m)
+		if !ok {
+			return errInvalidEvidence
+		}
+		digests[i] = digest
+	}
+	if digests[0] == digests[1] {
+		return errInvalidEvidence
+	}
+	return nil
+}
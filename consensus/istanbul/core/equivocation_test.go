@@ -0,0 +1,154 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	qbfttypes "github.com/electroneum/electroneum-sc/consensus/istanbul/types"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/rlp"
+)
+
+// evidenceCaptureBackend records the equivocation evidence reported by the core.
+type evidenceCaptureBackend struct {
+	commitCaptureBackend
+	evidences []*istanbul.EquivocationEvidence
+}
+
+func (b *evidenceCaptureBackend) ReportEquivocation(evidence *istanbul.EquivocationEvidence) {
+	b.evidences = append(b.evidences, evidence)
+}
+
+// signPrepare builds a PREPARE signed by the given key, returning it along with
+// its wire encoding.
+func signPrepare(t *testing.T, key *ecdsa.PrivateKey, seq, round int64, digest common.Hash) (*qbfttypes.Prepare, []byte) {
+	t.Helper()
+	prepare := qbfttypes.NewPrepare(big.NewInt(seq), big.NewInt(round), digest)
+	payload, err := prepare.EncodePayloadForSigning()
+	if err != nil {
+		t.Fatalf("failed to encode prepare: %v", err)
+	}
+	sig, err := crypto.Sign(crypto.Keccak256(payload), key)
+	if err != nil {
+		t.Fatalf("failed to sign prepare: %v", err)
+	}
+	prepare.SetSignature(sig)
+	prepare.SetSource(crypto.PubkeyToAddress(key.PublicKey))
+
+	data, err := rlp.EncodeToBytes(prepare)
+	if err != nil {
+		t.Fatalf("failed to encode prepare: %v", err)
+	}
+	return prepare, data
+}
+
+func TestCheckEquivocation(t *testing.T) {
+	valSet, keys := newKeyedValidatorSet(t, 4)
+	block := makeProposalBlock(t, 1)
+	backend := &evidenceCaptureBackend{}
+
+	c := newCommitTestCore(valSet, block, 1, 0)
+	c.backend = backend
+	c.signedMessages = make(map[signedMessageKey]*signedMessage)
+
+	src := valSet.List()[1].Address()
+	honest, honestData := signPrepare(t, keys[src], 1, 0, block.Hash())
+	conflict, conflictData := signPrepare(t, keys[src], 1, 0, common.HexToHash("0xdeadbeef"))
+	other, otherData := signPrepare(t, keys[src], 1, 1, common.HexToHash("0xdeadbeef"))
+
+	// Duplicates and messages of other rounds are fine
+	c.checkEquivocation(honest, honestData)
+	c.checkEquivocation(honest, honestData)
+	c.checkEquivocation(other, otherData)
+	if len(backend.evidences) != 0 {
+		t.Fatalf("unexpected evidence reported: %v", backend.evidences)
+	}
+	// Conflicting messages are reported once
+	c.checkEquivocation(conflict, conflictData)
+	c.checkEquivocation(conflict, conflictData)
+	if len(backend.evidences) != 1 {
+		t.Fatalf("evidence count mismatch: have %d, want 1", len(backend.evidences))
+	}
+	evidence := backend.evidences[0]
+	if evidence.Validator != src || evidence.Code != qbfttypes.PrepareCode || evidence.Sequence != 1 || evidence.Round != 0 {
+		t.Errorf("evidence mismatch: have %+v", evidence)
+	}
+	if err := VerifyEquivocationEvidence(evidence); err != nil {
+		t.Errorf("failed to verify evidence: %v", err)
+	}
+
+	// Evidence must not be attributable to another validator
+	forged := *evidence
+	forged.Validator = valSet.List()[2].Address()
+	if err := VerifyEquivocationEvidence(&forged); err != errInvalidEvidence {
+		t.Errorf("forged evidence error mismatch: have %v, want %v", err, errInvalidEvidence)
+	}
+	// Nor made of two identical messages
+	forged = *evidence
+	forged.Second = forged.First
+	if err := VerifyEquivocationEvidence(&forged); err != errInvalidEvidence {
+		t.Errorf("duplicate evidence error mismatch: have %v, want %v", err, errInvalidEvidence)
+	}
+}
+
+func TestCheckEquivocationWindow(t *testing.T) {
+	valSet, keys := newKeyedValidatorSet(t, 4)
+	block := makeProposalBlock(t, 1)
+	backend := &evidenceCaptureBackend{}
+
+	c := newCommitTestCore(valSet, block, 1, 0)
+	c.backend = backend
+	c.signedMessages = make(map[signedMessageKey]*signedMessage)
+
+	// Messages of non-validators and outside the window are not tracked
+	outsider, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	src := keys[valSet.List()[1].Address()]
+	for _, msg := range []struct {
+		key        *ecdsa.PrivateKey
+		seq, round int64
+	}{
+		{outsider, 1, 0},
+		{src, 2 + int64(MaxFutureSequenceGap), 0},
+		{src, 1, 1 + int64(MaxFutureRoundGap)},
+	} {
+		first, firstData := signPrepare(t, msg.key, msg.seq, msg.round, block.Hash())
+		second, secondData := signPrepare(t, msg.key, msg.seq, msg.round, common.HexToHash("0xdeadbeef"))
+		c.checkEquivocation(first, firstData)
+		c.checkEquivocation(second, secondData)
+	}
+	if len(backend.evidences) != 0 || len(c.signedMessages) != 0 {
+		t.Fatalf("messages outside the window tracked: %d evidences, %d messages", len(backend.evidences), len(c.signedMessages))
+	}
+
+	// Rounds falling behind the window are forgotten as the view moves on
+	msg, data := signPrepare(t, src, 1, 0, block.Hash())
+	c.checkEquivocation(msg, data)
+	c.current.SetRound(big.NewInt(int64(MaxFutureRoundGap) + 1))
+	msg, data = signPrepare(t, src, 1, int64(MaxFutureRoundGap)+1, block.Hash())
+	c.checkEquivocation(msg, data)
+	if len(c.signedMessages) != 1 {
+		t.Errorf("tracked message count mismatch: have %d, want 1", len(c.signedMessages))
+	}
+}
@@ -189,6 +189,8 @@ func (c *core) handleEncodedMsg(code uint64, data []byte) error {
 	if err = c.verifySignatures(m); err != nil {
 		return err
 	}
+	c.journalMessage(m)
+	c.checkEquivocation(m, data)
 
 	return c.handleDecodedMessage(m)
 }
@@ -234,6 +236,7 @@ func (c *core) handleTimeoutMsg() {
 	nextRound := new(big.Int).Add(round, common.Big1)
 
 	logger.Warn("[Consensus]: Round reached timeout", "pr", c.current.preparedRound)
+	c.journalTimeout()
 	c.startNewRound(nextRound)
 	logger.Trace("IBFT: TIMER CHANGED ROUND", "pr", c.current.preparedRound)
 
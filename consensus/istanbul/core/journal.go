@@ -0,0 +1,97 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	qbfttypes "github.com/electroneum/electroneum-sc/consensus/istanbul/types"
+)
+
+// messageCodeNames maps the QBFT message codes to their journaled names
+var messageCodeNames = map[uint64]string{
+	qbfttypes.PreprepareCode:  "PREPREPARE",
+	qbfttypes.PrepareCode:     "PREPARE",
+	qbfttypes.CommitCode:      "COMMIT",
+	qbfttypes.RoundChangeCode: "ROUND-CHANGE",
+}
+
+// journalMessage records a received message, whose signatures have already been
+// verified, into the round journal if there is one.
+func (c *core) journalMessage(m qbfttypes.QBFTMessage) {
+	journal := c.backend.RoundJournal()
+	if journal == nil || !c.withinRecordingWindow(m) {
+		return
+	}
+	view := m.View()
+	journal.JournalMessage(view.Sequence.Uint64(), view.Round.Uint64(), newRoundMessage(m, time.Now()))
+}
+
+// journalTimeout records the round change timer of the current round firing into
+// the round journal if there is one.
+func (c *core) journalTimeout() {
+	journal := c.backend.RoundJournal()
+	if journal == nil {
+		return
+	}
+	journal.JournalTimeout(c.current.Sequence().Uint64(), c.current.Round().Uint64(), time.Now())
+}
+
+// newRoundMessage converts a QBFT message, along with any justification piggybacked
+// in it, into its journaled form.
+func newRoundMessage(m qbfttypes.QBFTMessage, at time.Time) *istanbul.RoundMessage {
+	msg := &istanbul.RoundMessage{
+		Code:   messageCodeNames[m.Code()],
+		Source: m.Source(),
+		Time:   at,
+	}
+	digest := func(hash common.Hash) *common.Hash { return &hash }
+
+	switch m := m.(type) {
+	case *qbfttypes.Preprepare:
+		msg.Digest = digest(m.Proposal.Hash())
+		for _, rc := range m.JustificationRoundChanges {
+			msg.Justification = append(msg.Justification, newRoundMessage(rc, time.Time{}))
+		}
+		for _, p := range m.JustificationPrepares {
+			msg.Justification = append(msg.Justification, newRoundMessage(p, time.Time{}))
+		}
+	case *qbfttypes.Prepare:
+		msg.Digest = digest(m.Digest)
+	case *qbfttypes.Commit:
+		msg.Digest = digest(m.Digest)
+	case *qbfttypes.RoundChange:
+		setPrepared(msg, &m.SignedRoundChangePayload)
+		for _, p := range m.Justification {
+			msg.Justification = append(msg.Justification, newRoundMessage(p, time.Time{}))
+		}
+	case *qbfttypes.SignedRoundChangePayload:
+		setPrepared(msg, m)
+	}
+	return msg
+}
+
+// setPrepared records the prepared round and proposal of a round change, if any.
+func setPrepared(msg *istanbul.RoundMessage, rc *qbfttypes.SignedRoundChangePayload) {
+	if rc.PreparedRound == nil || rc.PreparedDigest == (common.Hash{}) {
+		return
+	}
+	round, digest := rc.PreparedRound.Uint64(), rc.PreparedDigest
+	msg.PreparedRound, msg.PreparedDigest = &round, &digest
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/crypto"
+)
+
+func TestWithinRecordingWindow(t *testing.T) {
+	valSet, keys := newKeyedValidatorSet(t, 4)
+	block := makeProposalBlock(t, 1)
+	c := newCommitTestCore(valSet, block, 1, 0)
+
+	outsider, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	validator := keys[valSet.List()[0].Address()]
+
+	tests := []struct {
+		name  string
+		seq   int64
+		round int64
+		want  bool
+	}{
+		{"current view", 1, 0, true},
+		{"previous height", 0, 0, true},
+		{"future round", 1, int64(MaxFutureRoundGap), true},
+		{"far future round", 1, int64(MaxFutureRoundGap) + 1, false},
+		{"future height", 1 + int64(MaxFutureSequenceGap), 0, true},
+		{"far future height", 2 + int64(MaxFutureSequenceGap), 0, false},
+	}
+	for _, tt := range tests {
+		msg, _ := signPrepare(t, validator, tt.seq, tt.round, block.Hash())
+		if have := c.withinRecordingWindow(msg); have != tt.want {
+			t.Errorf("%s: have %v, want %v", tt.name, have, tt.want)
+		}
+	}
+	// Messages of non-validators are never recorded
+	msg, _ := signPrepare(t, outsider, 1, 0, block.Hash())
+	if c.withinRecordingWindow(msg) {
+		t.Errorf("message of a non-validator accepted")
+	}
+}
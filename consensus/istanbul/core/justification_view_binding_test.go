@@ -310,9 +310,11 @@ func (b *viewBindingBackend) GetProposer(uint64) common.Address     { return com
 func (b *viewBindingBackend) ParentValidators(istanbul.Proposal) istanbul.ValidatorSet {
 	return nil
 }
-func (b *viewBindingBackend) HasBadProposal(common.Hash) bool { return false }
-func (b *viewBindingBackend) Close() error                    { return nil }
-func (b *viewBindingBackend) StartQBFTConsensus() error       { return nil }
+func (b *viewBindingBackend) HasBadProposal(common.Hash) bool                   { return false }
+func (b *viewBindingBackend) RoundJournal() istanbul.RoundJournal               { return nil }
+func (b *viewBindingBackend) ReportEquivocation(*istanbul.EquivocationEvidence) {}
+func (b *viewBindingBackend) Close() error                                      { return nil }
+func (b *viewBindingBackend) StartQBFTConsensus() error                         { return nil }
 
 func signQBFTMessage(t *testing.T, msg qbfttypes.QBFTMessage, key *ecdsa.PrivateKey) {
 	t.Helper()
@@ -387,6 +389,7 @@ func buildViewBindingCore(t *testing.T) (*core, *viewBindingBackend, common.Addr
 		roundChangeSet:    newRoundChangeSet(valSet),
 		pendingRequests:   prque.New(nil),
 		pendingRequestsMu: new(sync.Mutex),
+		signedMessages:    make(map[signedMessageKey]*signedMessage),
 	}
 
 	return c, backend, valSet.GetProposer().Address(), keyByAddr, lockedBlock
@@ -0,0 +1,220 @@
+package qbftengine
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	istanbulcommon "github.com/electroneum/electroneum-sc/consensus/istanbul/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
+	"github.com/electroneum/electroneum-sc/core/types"
+)
+
+var (
+	// errUnorderedValidatorSetChanges is returned if the headers of a validator set
+	// proof aren't in ascending order.
+	errUnorderedValidatorSetChanges = errors.New("validator set changes out of order")
+
+	// errInvalidValidatorSetChange is returned if the validators of a validator set
+	// change don't follow from its header.
+	errInvalidValidatorSetChange = errors.New("invalid validator set change")
+)
+
+// ValidatorSetChange is a header after which the validator set changed, along with
+// the validators sealing the blocks that follow it. Changes voted in by headers
+// also carry every header since the last checkpoint, which the votes are tallied
+// from.
+type ValidatorSetChange struct {
+	Header     *types.Header    `json:"header"`
+	Validators []common.Address `json:"validators"`
+	Votes      []*types.Header  `json:"votes,omitempty"`
+}
+
+// VerifyValidatorSetChanges follows a chain of validator set changes starting from
+// a trusted validator set, and returns the validator set in force after the last
+// change. Every header must be finalized by a quorum of the validators in force
+// before it.
+//
+// Every change must follow from data committed to by its header: the validators
+// listed in its extra data if it is a checkpoint carrying the validator contract
+// set, or else the header votes since the last checkpoint replayed the way the
+// voting snapshot tallies them. The headers of the votes are committed to by the
+// parent hash of the change header.
+func (e *Engine) VerifyValidatorSetChanges(validators []common.Address, changes []*ValidatorSetChange) ([]common.Address, error) {
+	current := validators
+
+	var number uint64
+	for _, change := range changes {
+		if change.Header == nil || change.Header.Number == nil || change.Header.Number.Uint64() <= number {
+			return nil, errUnorderedValidatorSetChanges
+		}
+		number = change.Header.Number.Uint64()
+
+		valSet := validator.NewSet(current, istanbul.NewProposerPolicy(istanbul.RoundRobin))
+		if err := e.verifyCommittedSeals(nil, change.Header, nil, valSet); err != nil {
+			return nil, err
+		}
+		next := change.Validators
+		if len(next) == 0 {
+			return nil, errInvalidValidatorSetChange
+		}
+		listed, err := e.Validators(change.Header)
+		if err != nil {
+			return nil, err
+		}
+		if e.cfg.IsValidatorContractMode(change.Header.Number) {
+			if !e.isCheckpoint(change.Header.Number) || !sameMembers(listed, next) {
+				return nil, errInvalidValidatorSetChange
+			}
+		} else {
+			voted, err := e.replayVotes(change.Votes, change.Header)
+			if err != nil {
+				return nil, err
+			}
+			if !sameMembers(listed, current) || !sameMembers(voted, next) {
+				return nil, errInvalidValidatorSetChange
+			}
+		}
+		current = next
+	}
+	return append([]common.Address{}, current...), nil
+}
+
+// isCheckpoint reports whether the votes are reset at the given block.
+func (e *Engine) isCheckpoint(number *big.Int) bool {
+	epoch := e.cfg.GetConfig(number).Epoch
+	return epoch != 0 && number.Uint64()%epoch == 0
+}
+
+// replayVotes tallies the votes of the given headers since the last checkpoint,
+// followed by the header changing the validator set, and returns the validators
+// in force after it. The headers must be linked by their parent hashes to the
+// last one, and list the validators in force before them as they are prepared.
+func (e *Engine) replayVotes(headers []*types.Header, last *types.Header) ([]common.Address, error) {
+	headers = append(append([]*types.Header{}, headers...), last)
+	for _, header := range headers {
+		if header == nil || header.Number == nil {
+			return nil, errInvalidValidatorSetChange
+		}
+	}
+	if !e.isCheckpoint(headers[0].Number) {
+		return nil, errInvalidValidatorSetChange
+	}
+	for i := 1; i < len(headers); i++ {
+		if headers[i].Number.Uint64() != headers[i-1].Number.Uint64()+1 || headers[i].ParentHash != headers[i-1].Hash() {
+			return nil, errInvalidValidatorSetChange
+		}
+		if i < len(headers)-1 && e.isCheckpoint(headers[i].Number) {
+			return nil, errInvalidValidatorSetChange
+		}
+	}
+	current, err := e.Validators(headers[0])
+	if err != nil {
+		return nil, err
+	}
+	var votes []validatorVote
+	for _, header := range headers {
+		listed, err := e.Validators(header)
+		if err != nil {
+			return nil, err
+		}
+		if !sameMembers(listed, current) {
+			return nil, errInvalidValidatorSetChange
+		}
+		// The genesis header sets the validators up without being voted on
+		if header.Number.Sign() == 0 {
+			continue
+		}
+		author, err := e.Author(header)
+		if err != nil {
+			return nil, err
+		}
+		candidate, authorize, err := e.ReadVote(header)
+		if err != nil {
+			return nil, err
+		}
+		if current, votes, err = tallyVote(current, votes, author, candidate, authorize); err != nil {
+			return nil, err
+		}
+	}
+	return current, nil
+}
+
+// validatorVote is a vote cast by a validator on a candidate, as tallied by the
+// voting snapshot.
+type validatorVote struct {
+	validator common.Address
+	candidate common.Address
+	authorize bool
+}
+
+// tallyVote casts the vote of a header authored by the given validator, and
+// returns the validators and the pending votes after it, the way the voting
+// snapshot applies a header.
+func tallyVote(validators []common.Address, votes []validatorVote, author, candidate common.Address, authorize bool) ([]common.Address, []validatorVote, error) {
+	members := make(map[common.Address]bool, len(validators))
+	for _, addr := range validators {
+		members[addr] = true
+	}
+	if !members[author] {
+		return nil, nil, istanbulcommon.ErrUnauthorized
+	}
+	// Discard any previous vote of the author on the candidate
+	for i, vote := range votes {
+		if vote.validator == author && vote.candidate == candidate {
+			votes = append(votes[:i:i], votes[i+1:]...)
+			break
+		}
+	}
+	if members[candidate] == authorize {
+		return validators, votes, nil
+	}
+	votes = append(votes, validatorVote{validator: author, candidate: candidate, authorize: authorize})
+
+	var tally int
+	for _, vote := range votes {
+		if vote.candidate == candidate {
+			tally++
+		}
+	}
+	if tally <= len(validators)/2 {
+		return validators, votes, nil
+	}
+	// The vote passed, update the validators and drop the votes around them
+	next := make([]common.Address, 0, len(validators)+1)
+	for _, addr := range validators {
+		if addr != candidate {
+			next = append(next, addr)
+		}
+	}
+	if authorize {
+		next = append(next, candidate)
+	}
+	pending := votes[:0:0]
+	for _, vote := range votes {
+		if vote.candidate != candidate && (authorize || vote.validator != candidate) {
+			pending = append(pending, vote)
+		}
+	}
+	return next, pending, nil
+}
+
+// sameMembers reports whether two address lists hold the same addresses, whatever
+// their order.
+func sameMembers(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	members := make(map[common.Address]bool, len(a))
+	for _, addr := range a {
+		members[addr] = true
+	}
+	for _, addr := range b {
+		if !members[addr] {
+			return false
+		}
+		delete(members, addr)
+	}
+	return len(members) == 0
+}
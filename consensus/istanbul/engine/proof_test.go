@@ -0,0 +1,139 @@
+package qbftengine
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	istanbulcommon "github.com/electroneum/electroneum-sc/consensus/istanbul/common"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
+)
+
+// sealedHeader builds a header of the given height on top of the given parent,
+// authored by the given validator and carrying the given vote and validators,
+// with the committed seals of the given keys.
+func sealedHeader(t *testing.T, parent *types.Header, number int64, author common.Address, vote *types.ValidatorVote, validators []common.Address, keys ...*ecdsa.PrivateKey) *types.Header {
+	t.Helper()
+	header := &types.Header{
+		Number:     big.NewInt(number),
+		Coinbase:   author,
+		MixDigest:  types.IstanbulDigest,
+		Difficulty: istanbulcommon.DefaultDifficulty,
+		UncleHash:  types.EmptyUncleHash,
+		Time:       uint64(number),
+		GasLimit:   30_000_000,
+	}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+	}
+	applies := []ApplyQBFTExtra{writeRoundNumber(big.NewInt(0)), WriteValidators(validators)}
+	if vote != nil {
+		applies = append(applies, WriteVote(vote.RecipientAddress, vote.VoteType == types.QBFTAuthVote))
+	}
+	if err := ApplyHeaderQBFTExtra(header, applies...); err != nil {
+		t.Fatalf("failed to apply extra: %v", err)
+	}
+	var seals [][]byte
+	for _, key := range keys {
+		seal, err := crypto.Sign(PrepareCommittedSeal(header, 0), key)
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		seals = append(seals, seal)
+	}
+	if err := ApplyHeaderQBFTExtra(header, writeCommittedSeals(seals)); err != nil {
+		t.Fatalf("failed to write seals: %v", err)
+	}
+	return header
+}
+
+func TestVerifyValidatorSetChanges(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 5)
+	addrs := make([]common.Address, 5)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	engine := NewEngine(&istanbul.Config{Epoch: 10}, common.Address{}, nil)
+
+	// After the checkpoint, three of the first four validators vote the fifth in,
+	// then three of the five vote the first out
+	var (
+		addVote    = &types.ValidatorVote{RecipientAddress: addrs[4], VoteType: types.QBFTAuthVote}
+		removeVote = &types.ValidatorVote{RecipientAddress: addrs[0], VoteType: types.QBFTDropVote}
+		headers    = []*types.Header{sealedHeader(t, nil, 10, addrs[0], nil, addrs[:4], keys[:3]...)}
+	)
+	for i := 0; i < 3; i++ {
+		headers = append(headers, sealedHeader(t, headers[i], int64(11+i), addrs[i], addVote, addrs[:4], keys[:3]...))
+	}
+	for i := 0; i < 3; i++ {
+		headers = append(headers, sealedHeader(t, headers[3+i], int64(14+i), addrs[1+i], removeVote, addrs, keys[1:5]...))
+	}
+	changes := []*ValidatorSetChange{
+		{Header: headers[3], Validators: addrs, Votes: headers[:3]},
+		{Header: headers[6], Validators: addrs[1:], Votes: headers[:6]},
+	}
+	have, err := engine.VerifyValidatorSetChanges(addrs[:4], changes)
+	if err != nil {
+		t.Fatalf("failed to verify changes: %v", err)
+	}
+	if !reflect.DeepEqual(have, addrs[1:]) {
+		t.Errorf("validators mismatch: have %v, want %v", have, addrs[1:])
+	}
+
+	// The validator contract set is carried by the checkpoint headers only
+	contract := NewEngine(&istanbul.Config{Epoch: 10, Transitions: []params.Transition{{
+		Block:                    big.NewInt(0),
+		ValidatorSelectionMode:   params.ContractMode,
+		ValidatorContractAddress: common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+	}}}, common.Address{}, nil)
+
+	checkpoint := sealedHeader(t, nil, 20, addrs[1], nil, addrs[1:4], keys[:3]...)
+	if have, err = contract.VerifyValidatorSetChanges(addrs[:4], []*ValidatorSetChange{{Header: checkpoint, Validators: addrs[1:4]}}); err != nil {
+		t.Fatalf("failed to verify contract change: %v", err)
+	}
+	if !reflect.DeepEqual(have, addrs[1:4]) {
+		t.Errorf("contract validators mismatch: have %v, want %v", have, addrs[1:4])
+	}
+
+	tests := []struct {
+		engine     *Engine
+		validators []common.Address
+		changes    []*ValidatorSetChange
+		err        error
+	}{
+		// Headers out of order
+		{engine, addrs[:4], []*ValidatorSetChange{changes[1], changes[0]}, istanbulcommon.ErrInvalidCommittedSeals},
+		{engine, addrs[:4], []*ValidatorSetChange{changes[0], changes[0]}, errUnorderedValidatorSetChanges},
+		// Header not finalized by the trusted validators
+		{engine, addrs[1:], changes, istanbulcommon.ErrInvalidCommittedSeals},
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: sealedHeader(t, headers[2], 13, addrs[2], addVote, addrs[:4], keys[:2]...), Validators: addrs, Votes: headers[:3]}}, istanbulcommon.ErrInvalidCommittedSeals},
+		// Validators not matching the votes
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: addrs[1:], Votes: headers[:3]}}, errInvalidValidatorSetChange},
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[1], Validators: addrs, Votes: headers[:1]}}, errInvalidValidatorSetChange},
+		// Votes missing, not linked or not starting at a checkpoint
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: addrs}}, errInvalidValidatorSetChange},
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: addrs, Votes: []*types.Header{headers[0], headers[2]}}}, errInvalidValidatorSetChange},
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: addrs, Votes: headers[1:3]}}, errInvalidValidatorSetChange},
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: addrs, Votes: []*types.Header{nil, headers[1], headers[2]}}}, errInvalidValidatorSetChange},
+		// Votes cast by a non validator
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: sealedHeader(t, headers[0], 11, addrs[4], addVote, addrs[:4], keys[:3]...), Validators: addrs, Votes: headers[:1]}}, istanbulcommon.ErrUnauthorized},
+		// Validators listed by a checkpoint outside the validator contract mode
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: checkpoint, Validators: addrs[1:4]}}, errInvalidValidatorSetChange},
+		// Validators not listed by a checkpoint in the validator contract mode
+		{contract, addrs[:4], []*ValidatorSetChange{{Header: checkpoint, Validators: addrs[2:4]}}, errInvalidValidatorSetChange},
+		{contract, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: addrs, Votes: headers[:3]}}, errInvalidValidatorSetChange},
+		// No validators left
+		{engine, addrs[:4], []*ValidatorSetChange{{Header: headers[3], Validators: nil, Votes: headers[:3]}}, errInvalidValidatorSetChange},
+	}
+	for i, test := range tests {
+		if _, err := test.engine.VerifyValidatorSetChanges(test.validators, test.changes); err != test.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package istanbul
+
+import (
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+)
+
+// EquivocationEvidence is the proof of a validator signing two conflicting messages
+// of the same type for the same height and round, e.g. two PREPAREs for different
+// proposals. Both messages are kept exactly as received, signature included, so
+// anyone can verify them independently.
+type EquivocationEvidence struct {
+	Validator common.Address `json:"validator"`
+	Code      uint64         `json:"code"` // QBFT message code of both messages
+	Sequence  uint64         `json:"sequence"`
+	Round     uint64         `json:"round"`
+	First     hexutil.Bytes  `json:"first"`  // RLP encoded signed message received first
+	Second    hexutil.Bytes  `json:"second"` // RLP encoded signed message conflicting with the first
+	Detected  time.Time      `json:"detected"`
+}
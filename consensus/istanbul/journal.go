@@ -0,0 +1,74 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package istanbul
+
+import (
+	"sort"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+)
+
+// RoundJournal records what happened in each round of the consensus, so round
+// changes can be investigated after the fact.
+type RoundJournal interface {
+	// JournalMessage records a message received for the given height and round
+	JournalMessage(sequence uint64, round uint64, msg *RoundMessage)
+
+	// JournalTimeout records the round change timer of the given height and round
+	// firing at the given time
+	JournalTimeout(sequence uint64, round uint64, at time.Time)
+}
+
+// RoundMessage is a consensus message as recorded in the round journal.
+type RoundMessage struct {
+	Code   string         `json:"code"`   // Message type, e.g. PREPARE
+	Source common.Address `json:"source"` // Validator that signed the message
+	Time   time.Time      `json:"time"`   // When the message was received, zero for justifications
+
+	Digest         *common.Hash `json:"digest,omitempty"`         // Proposal the message is about
+	PreparedRound  *uint64      `json:"preparedRound,omitempty"`  // Prepared round of a round change
+	PreparedDigest *common.Hash `json:"preparedDigest,omitempty"` // Prepared proposal of a round change
+
+	Justification []*RoundMessage `json:"justification,omitempty"` // Piggybacked signed payloads
+}
+
+// RoundRecord is everything journaled for a single round of a height.
+type RoundRecord struct {
+	Round    uint64          `json:"round"`
+	Messages []*RoundMessage `json:"messages"`
+	Timeouts []time.Time     `json:"timeouts"` // When the round change timer fired
+}
+
+// RoundHistory is everything journaled for a single height.
+type RoundHistory struct {
+	Sequence uint64         `json:"sequence"`
+	Rounds   []*RoundRecord `json:"rounds"` // Ordered by round number
+}
+
+// Round returns the record of the given round, creating it if needed.
+func (h *RoundHistory) Round(round uint64) *RoundRecord {
+	i := sort.Search(len(h.Rounds), func(i int) bool { return h.Rounds[i].Round >= round })
+	if i < len(h.Rounds) && h.Rounds[i].Round == round {
+		return h.Rounds[i]
+	}
+	record := &RoundRecord{Round: round, Messages: []*RoundMessage{}, Timeouts: []time.Time{}}
+	h.Rounds = append(h.Rounds, nil)
+	copy(h.Rounds[i+1:], h.Rounds[i:])
+	h.Rounds[i] = record
+	return record
+}
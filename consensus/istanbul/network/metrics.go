@@ -0,0 +1,30 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package network
+
+import "github.com/electroneum/electroneum-sc/metrics"
+
+var (
+	ingressMessageMeter = metrics.NewRegisteredMeter("consensus/istanbul/network/in/messages", nil)
+	ingressTrafficMeter = metrics.NewRegisteredMeter("consensus/istanbul/network/in/traffic", nil)
+	ingressRateMeter    = metrics.NewRegisteredMeter("consensus/istanbul/network/in/ratelimited", nil)
+	ingressPeerMeter    = metrics.NewRegisteredMeter("consensus/istanbul/network/in/nonvalidator", nil)
+	egressMessageMeter  = metrics.NewRegisteredMeter("consensus/istanbul/network/out/messages", nil)
+	egressTrafficMeter  = metrics.NewRegisteredMeter("consensus/istanbul/network/out/traffic", nil)
+	egressDropMeter     = metrics.NewRegisteredMeter("consensus/istanbul/network/out/dropped", nil)
+	peerGauge           = metrics.NewRegisteredGauge("consensus/istanbul/network/peers", nil)
+)
@@ -0,0 +1,192 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/p2p"
+	"github.com/electroneum/electroneum-sc/p2p/enode"
+	"github.com/electroneum/electroneum-sc/rlp"
+	"golang.org/x/time/rate"
+)
+
+// maxQueuedMessages is the maximum number of consensus messages queued for
+// sending to a single peer before new ones get dropped.
+const maxQueuedMessages = 1024
+
+var (
+	errPeerAlreadyRegistered = errors.New("peer already registered")
+	errPeerSetClosed         = errors.New("peer set closed")
+)
+
+// outboundMessage is a consensus message waiting to be sent to a peer.
+type outboundMessage struct {
+	code    uint64
+	payload []byte
+}
+
+// peer is a remote node speaking the consensus protocol. Messages to it are sent
+// from a dedicated queue, so they never wait on block or transaction propagation.
+type peer struct {
+	*p2p.Peer
+	rw      p2p.MsgReadWriter
+	address common.Address
+	limiter *rate.Limiter
+
+	queue chan *outboundMessage
+	term  chan struct{}
+}
+
+func newPeer(p *p2p.Peer, rw p2p.MsgReadWriter, config Config) *peer {
+	return &peer{
+		Peer:    p,
+		rw:      rw,
+		address: crypto.PubkeyToAddress(*p.Node().Pubkey()),
+		limiter: rate.NewLimiter(rate.Limit(config.MessageRate), config.MessageBurst),
+		queue:   make(chan *outboundMessage, maxQueuedMessages),
+		term:    make(chan struct{}),
+	}
+}
+
+// broadcast sends the queued messages to the peer until it's closed, or until a
+// write fails, as the connection is being torn down then.
+func (p *peer) broadcast() {
+	for {
+		select {
+		case msg := <-p.queue:
+			if err := p2p.SendWithNoEncoding(p.rw, msg.code, msg.payload); err != nil {
+				p.Log().Debug("Failed to send consensus message", "err", err)
+				return
+			}
+			egressMessageMeter.Mark(1)
+			egressTrafficMeter.Mark(int64(len(msg.payload)))
+		case <-p.term:
+			return
+		}
+	}
+}
+
+// close stops sending messages to the peer.
+func (p *peer) close() {
+	close(p.term)
+}
+
+// Send implements consensus.Peer, encoding and queueing the message.
+func (p *peer) Send(msgcode uint64, data interface{}) error {
+	payload, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return err
+	}
+	return p.SendQBFTConsensus(msgcode, payload)
+}
+
+// SendConsensus implements consensus.Peer, encoding and queueing the message.
+func (p *peer) SendConsensus(msgcode uint64, data interface{}) error {
+	return p.Send(msgcode, data)
+}
+
+// SendQBFTConsensus implements consensus.Peer, queueing the already encoded
+// message. The message is dropped if the peer can't keep up.
+func (p *peer) SendQBFTConsensus(msgcode uint64, payload []byte) error {
+	select {
+	case p.queue <- &outboundMessage{code: msgcode, payload: payload}:
+	default:
+		egressDropMeter.Mark(1)
+		p.Log().Debug("Dropping consensus message, queue full", "code", msgcode)
+	}
+	return nil
+}
+
+// peerSet is the set of peers speaking the consensus protocol, indexed by the
+// address of their node key.
+type peerSet struct {
+	peers  map[common.Address]*peer
+	lock   sync.RWMutex
+	closed bool
+}
+
+func newPeerSet() *peerSet {
+	return &peerSet{peers: make(map[common.Address]*peer)}
+}
+
+func (ps *peerSet) register(p *peer) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	if ps.closed {
+		return errPeerSetClosed
+	}
+	if _, ok := ps.peers[p.address]; ok {
+		return errPeerAlreadyRegistered
+	}
+	ps.peers[p.address] = p
+	peerGauge.Update(int64(len(ps.peers)))
+	return nil
+}
+
+func (ps *peerSet) unregister(p *peer) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	if ps.peers[p.address] == p {
+		delete(ps.peers, p.address)
+	}
+	peerGauge.Update(int64(len(ps.peers)))
+}
+
+// find returns the peers whose address is among the targets.
+func (ps *peerSet) find(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	found := make(map[common.Address]consensus.Peer)
+	for addr := range targets {
+		if p, ok := ps.peers[addr]; ok {
+			found[addr] = p
+		}
+	}
+	return found
+}
+
+// peer returns the peer with the given node ID, if registered.
+func (ps *peerSet) peer(id enode.ID) *peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	for _, p := range ps.peers {
+		if p.ID() == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// close disconnects all peers and prevents new ones from registering.
+func (ps *peerSet) close() {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	for _, p := range ps.peers {
+		p.Disconnect(p2p.DiscQuitting)
+	}
+	ps.closed = true
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package network implements the devp2p service carrying the Istanbul consensus
+// messages, apart from the eth protocol.
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/node"
+	"github.com/electroneum/electroneum-sc/p2p"
+	"github.com/electroneum/electroneum-sc/p2p/enode"
+)
+
+// maxMessageSize is the maximum size of a consensus message.
+const maxMessageSize = 10 * 1024 * 1024
+
+var (
+	errNotIstanbul    = errors.New("consensus engine is not istanbul")
+	errMsgTooLarge    = errors.New("message too long")
+	errNoBroadcaster  = errors.New("consensus engine has no broadcaster")
+	errServiceStopped = errors.New("service stopped")
+)
+
+// Config are the configuration parameters of the consensus service.
+type Config struct {
+	// ValidatorsOnly drops the consensus messages of peers outside of the current
+	// validator set.
+	ValidatorsOnly bool
+
+	// MessageRate is the number of consensus messages per second accepted from a
+	// single peer, and MessageBurst the number accepted at once. Messages above the
+	// limits are dropped.
+	MessageRate  float64
+	MessageBurst int
+}
+
+// DefaultConfig contains the default settings of the consensus service.
+var DefaultConfig = Config{
+	MessageRate:  100,
+	MessageBurst: 400,
+}
+
+// Engine is the consensus engine whose messages are carried by the service.
+type Engine interface {
+	consensus.Handler
+
+	// Protocol returns the devp2p protocol of the engine.
+	Protocol() consensus.Protocol
+
+	// Broadcaster returns the broadcaster set on the engine.
+	Broadcaster() consensus.Broadcaster
+
+	// CurrentValidators returns the validators of the block following the chain
+	// head, or nil if unknown.
+	CurrentValidators() istanbul.ValidatorSet
+}
+
+// Service carries the consensus messages of the Istanbul engine over its own
+// devp2p protocol and peer set, so consensus traffic doesn't compete with the
+// eth protocol handler for block and transaction propagation.
+type Service struct {
+	config  Config
+	engine  Engine
+	fetcher consensus.Broadcaster // Broadcaster of the eth service, queueing blocks for import
+	peers   *peerSet
+	quit    chan struct{}
+}
+
+// New creates the consensus service of the given engine and registers it with
+// the node. The engine broadcaster, set by the eth service, must be in place as
+// committed blocks are still handed over to it.
+func New(stack *node.Node, engine consensus.Engine, config Config) (*Service, error) {
+	handler, ok := engine.(Engine)
+	if !ok {
+		return nil, errNotIstanbul
+	}
+	fetcher := handler.Broadcaster()
+	if fetcher == nil {
+		return nil, errNoBroadcaster
+	}
+	s := &Service{
+		config:  config,
+		engine:  handler,
+		fetcher: fetcher,
+		peers:   newPeerSet(),
+		quit:    make(chan struct{}),
+	}
+	handler.SetBroadcaster(s)
+
+	stack.RegisterProtocols(s.Protocols())
+	stack.RegisterLifecycle(s)
+	return s, nil
+}
+
+// Protocols returns the devp2p protocols of the consensus engine.
+func (s *Service) Protocols() []p2p.Protocol {
+	proto := s.engine.Protocol()
+
+	protos := make([]p2p.Protocol, len(proto.Versions))
+	for i, version := range proto.Versions {
+		length, ok := proto.Lengths[version]
+		if !ok {
+			panic(fmt.Sprintf("no message count for consensus protocol version %d", version))
+		}
+		protos[i] = p2p.Protocol{
+			Name:    proto.Name,
+			Version: version,
+			Length:  length,
+			Run:     s.runPeer,
+			PeerInfo: func(id enode.ID) interface{} {
+				if p := s.peers.peer(id); p != nil {
+					return p.address
+				}
+				return nil
+			},
+		}
+	}
+	return protos
+}
+
+// Start implements node.Lifecycle.
+func (s *Service) Start() error {
+	log.Info("Started consensus network service", "validatorsonly", s.config.ValidatorsOnly, "rate", s.config.MessageRate, "burst", s.config.MessageBurst)
+	return nil
+}
+
+// Stop implements node.Lifecycle, disconnecting all consensus peers.
+func (s *Service) Stop() error {
+	close(s.quit)
+	s.peers.close()
+	return nil
+}
+
+// Enqueue implements consensus.Broadcaster, handing the block over to the eth
+// service for import.
+func (s *Service) Enqueue(id string, block *types.Block) {
+	s.fetcher.Enqueue(id, block)
+}
+
+// FindPeers implements consensus.Broadcaster, returning the consensus peers
+// among the given addresses.
+func (s *Service) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	return s.peers.find(targets)
+}
+
+// runPeer registers a consensus peer and handles its messages until the
+// connection is torn down.
+func (s *Service) runPeer(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+	peer := newPeer(p, rw, s.config)
+	if err := s.peers.register(peer); err != nil {
+		return err
+	}
+	defer s.peers.unregister(peer)
+
+	go peer.broadcast()
+	defer peer.close()
+
+	for {
+		select {
+		case <-s.quit:
+			return errServiceStopped
+		default:
+		}
+		if err := s.handleMsg(peer); err != nil {
+			peer.Log().Debug("Consensus message handling failed", "err", err)
+			return err
+		}
+	}
+}
+
+// handleMsg reads the next message of a peer and hands it over to the engine,
+// unless it's over the rate limit or comes from a peer that isn't a validator.
+func (s *Service) handleMsg(p *peer) error {
+	msg, err := p.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+	if msg.Size > maxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	}
+	defer msg.Discard()
+
+	ingressMessageMeter.Mark(1)
+	ingressTrafficMeter.Mark(int64(msg.Size))
+
+	if s.config.ValidatorsOnly && !s.isValidator(p.address) {
+		ingressPeerMeter.Mark(1)
+		return nil
+	}
+	if !p.limiter.Allow() {
+		ingressRateMeter.Mark(1)
+		return nil
+	}
+	if _, err := s.engine.HandleMsg(p.address, msg); err != nil && !errors.Is(err, istanbul.ErrStoppedEngine) {
+		return err
+	}
+	return nil
+}
+
+// isValidator reports whether the address belongs to the current validator set.
+// Everyone is let through while the set is unknown, leaving it to the engine.
+func (s *Service) isValidator(addr common.Address) bool {
+	validators := s.engine.CurrentValidators()
+	if validators == nil {
+		return true
+	}
+	_, val := validators.GetByAddress(addr)
+	return val != nil
+}
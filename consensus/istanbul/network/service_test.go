@@ -0,0 +1,149 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/validator"
+	"github.com/electroneum/electroneum-sc/p2p"
+	"github.com/electroneum/electroneum-sc/p2p/enode"
+	"golang.org/x/time/rate"
+)
+
+type testEngine struct {
+	validators istanbul.ValidatorSet
+	handled    []common.Address
+}
+
+func (e *testEngine) NewChainHead() error                  { return nil }
+func (e *testEngine) SetBroadcaster(consensus.Broadcaster) {}
+func (e *testEngine) Protocol() consensus.Protocol         { return consensus.IstanbulProtocol }
+func (e *testEngine) Broadcaster() consensus.Broadcaster   { return nil }
+
+func (e *testEngine) CurrentValidators() istanbul.ValidatorSet { return e.validators }
+
+func (e *testEngine) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
+	e.handled = append(e.handled, addr)
+	return true, nil
+}
+
+func newTestPeer(addr common.Address, rw p2p.MsgReadWriter, config Config) *peer {
+	return &peer{
+		Peer:    p2p.NewPeer(enode.ID{addr[19]}, addr.Hex(), nil),
+		rw:      rw,
+		address: addr,
+		limiter: rate.NewLimiter(rate.Limit(config.MessageRate), config.MessageBurst),
+		queue:   make(chan *outboundMessage, maxQueuedMessages),
+		term:    make(chan struct{}),
+	}
+}
+
+func TestHandleMsg(t *testing.T) {
+	var (
+		val    = common.HexToAddress("0x01")
+		other  = common.HexToAddress("0x02")
+		engine = &testEngine{validators: validator.NewSet([]common.Address{val}, istanbul.NewRoundRobinProposerPolicy())}
+		config = Config{ValidatorsOnly: true, MessageRate: 0.001, MessageBurst: 2}
+		s      = &Service{config: config, engine: engine, peers: newPeerSet(), quit: make(chan struct{})}
+	)
+	tests := []struct {
+		addr    common.Address
+		sent    int
+		handled int
+	}{
+		{addr: val, sent: 3, handled: 2},   // messages over the burst are dropped
+		{addr: other, sent: 3, handled: 0}, // non-validators are dropped
+	}
+	for i, tt := range tests {
+		engine.handled = nil
+
+		in, out := p2p.MsgPipe()
+		p := newTestPeer(tt.addr, in, config)
+		go func() {
+			for j := 0; j < tt.sent; j++ {
+				p2p.Send(out, 0x12, []byte{byte(j)})
+			}
+		}()
+		for j := 0; j < tt.sent; j++ {
+			if err := s.handleMsg(p); err != nil {
+				t.Fatalf("test %d: failed to handle message: %v", i, err)
+			}
+		}
+		in.Close()
+
+		if len(engine.handled) != tt.handled {
+			t.Errorf("test %d: handled messages mismatch: have %d, want %d", i, len(engine.handled), tt.handled)
+		}
+		for _, addr := range engine.handled {
+			if addr != tt.addr {
+				t.Errorf("test %d: sender mismatch: have %v, want %v", i, addr, tt.addr)
+			}
+		}
+	}
+	// Everyone is let through while the validators are unknown
+	engine.validators = nil
+	if !s.isValidator(other) {
+		t.Errorf("peer rejected without a validator set")
+	}
+}
+
+func TestBroadcastWriteFailure(t *testing.T) {
+	in, out := p2p.MsgPipe()
+	p := newTestPeer(common.HexToAddress("0x01"), in, DefaultConfig)
+	defer p.close()
+
+	// A failed write ends the broadcast instead of writing to the dead connection
+	out.Close()
+	p.queue <- &outboundMessage{code: 0x12, payload: []byte{0xc0}}
+
+	done := make(chan struct{})
+	go func() {
+		p.broadcast()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("broadcast still running after a failed write")
+	}
+}
+
+func TestFindPeers(t *testing.T) {
+	var (
+		a  = common.HexToAddress("0x01")
+		b  = common.HexToAddress("0x02")
+		c  = common.HexToAddress("0x03")
+		ps = newPeerSet()
+	)
+	for _, addr := range []common.Address{a, b} {
+		if err := ps.register(newTestPeer(addr, nil, DefaultConfig)); err != nil {
+			t.Fatalf("failed to register peer %v: %v", addr, err)
+		}
+	}
+	if err := ps.register(newTestPeer(a, nil, DefaultConfig)); err != errPeerAlreadyRegistered {
+		t.Errorf("error mismatch: have %v, want %v", err, errPeerAlreadyRegistered)
+	}
+	found := ps.find(map[common.Address]bool{b: true, c: true})
+	if len(found) != 1 || found[b] == nil {
+		t.Errorf("found peers mismatch: have %v, want %v", found, b)
+	}
+}
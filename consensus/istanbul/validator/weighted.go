@@ -0,0 +1,150 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package validator
+
+import (
+	"math"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+)
+
+// weightedSet is a validator set using the weighted proposer policy. Picking the
+// proposer of a block in proportion to the weights can't be derived from the last
+// proposer alone, so the set is bound to the height it proposes for.
+type weightedSet struct {
+	*defaultSet
+
+	weights  map[common.Address]uint64
+	sequence uint64
+}
+
+// NewWeightedSet creates a validator set electing the proposer of the given height
+// according to the weights. Validators without a weight count as weight 1.
+func NewWeightedSet(addrs []common.Address, policy *istanbul.ProposerPolicy, weights map[common.Address]uint64, sequence uint64) istanbul.ValidatorSet {
+	cpy := make(map[common.Address]uint64, len(weights))
+	for addr, weight := range weights {
+		cpy[addr] = weight
+	}
+	return &weightedSet{
+		defaultSet: newDefaultSet(addrs, policy),
+		weights:    cpy,
+		sequence:   sequence,
+	}
+}
+
+// Weights returns the proposer weights of a set created by NewWeightedSet, and
+// the height it proposes for. Other sets report no weights.
+func Weights(valSet istanbul.ValidatorSet) (map[common.Address]uint64, uint64) {
+	if set, ok := valSet.(*weightedSet); ok {
+		return set.weights, set.sequence
+	}
+	return nil, 0
+}
+
+// weight returns the weight of a validator, capped so the total of the set can't
+// overflow.
+func (valSet *weightedSet) weight(addr common.Address) uint64 {
+	weight, ok := valSet.weights[addr]
+	if !ok {
+		return 1
+	}
+	if weight > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return weight
+}
+
+func (valSet *weightedSet) CalcProposer(lastProposer common.Address, round uint64) {
+	valSet.validatorMu.RLock()
+	defer valSet.validatorMu.RUnlock()
+	valSet.proposer = weightedProposer(valSet, lastProposer, round)
+}
+
+func (valSet *weightedSet) Copy() istanbul.ValidatorSet {
+	valSet.validatorMu.RLock()
+	defer valSet.validatorMu.RUnlock()
+
+	addresses := make([]common.Address, 0, len(valSet.validators))
+	for _, v := range valSet.validators {
+		addresses = append(addresses, v.Address())
+	}
+	return NewWeightedSet(addresses, valSet.policy, valSet.weights, valSet.sequence)
+}
+
+// weightedProposer lays the validators out on a ring of slots the way interleaved
+// weighted round robin does: the ring is made of rounds, each giving one slot to
+// every validator whose weight exceeds the round number, so validators take turns
+// instead of proposing their whole weight in a row. The owner of the slot of the
+// height is picked. Round changes move on to the next validators the same way the
+// round robin policy does, so an offline validator only costs a single round.
+func weightedProposer(valSet *weightedSet, proposer common.Address, round uint64) istanbul.Validator {
+	if valSet.Size() == 0 {
+		return nil
+	}
+	var (
+		weights = make([]uint64, len(valSet.validators))
+		total   uint64
+		highest uint64
+	)
+	for i, val := range valSet.validators {
+		weights[i] = valSet.weight(val.Address())
+		total += weights[i]
+		if weights[i] > highest {
+			highest = weights[i]
+		}
+	}
+	if total == 0 {
+		return roundRobinProposer(valSet, proposer, round)
+	}
+	// Find the ring round holding the slot of the height, then its owner
+	slot := valSet.sequence % total
+	lo, hi := uint64(0), highest
+	for lo < hi {
+		if mid := lo + (hi-lo)/2; slotsBefore(weights, mid+1) > slot {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	slot -= slotsBefore(weights, lo)
+	for i, weight := range weights {
+		if weight <= lo {
+			continue
+		}
+		if slot > 0 {
+			slot--
+			continue
+		}
+		pick := calcSeed(valSet, valSet.validators[i].Address(), round) % uint64(valSet.Size())
+		return valSet.GetByIndex(pick)
+	}
+	return nil
+}
+
+// slotsBefore returns the number of slots of the ring rounds before the given one.
+func slotsBefore(weights []uint64, round uint64) uint64 {
+	var slots uint64
+	for _, weight := range weights {
+		if weight < round {
+			slots += weight
+		} else {
+			slots += round
+		}
+	}
+	return slots
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package validator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul"
+)
+
+func newWeightedTestSet(weights map[common.Address]uint64, sequence uint64, addrs ...common.Address) istanbul.ValidatorSet {
+	policy := istanbul.NewProposerPolicyByIdAndSortFunc(istanbul.Weighted, istanbul.ValidatorSortByByte())
+	return NewWeightedSet(addrs, policy, weights, sequence)
+}
+
+func TestWeightedProposer(t *testing.T) {
+	var (
+		addr1 = common.HexToAddress("0x0000000000000000000000000000000000000001")
+		addr2 = common.HexToAddress("0x0000000000000000000000000000000000000002")
+		addr3 = common.HexToAddress("0x0000000000000000000000000000000000000003")
+	)
+	// addr2 has no weight configured, so it counts as 1
+	weights := map[common.Address]uint64{addr1: 3, addr3: 0}
+
+	// Shares of the round 0 proposals must follow the weights
+	proposed := make(map[common.Address]int)
+	for sequence := uint64(0); sequence < 40; sequence++ {
+		valSet := newWeightedTestSet(weights, sequence, addr1, addr2, addr3)
+		valSet.CalcProposer(common.Address{}, 0)
+		proposed[valSet.GetProposer().Address()]++
+	}
+	if proposed[addr1] != 30 || proposed[addr2] != 10 || proposed[addr3] != 0 {
+		t.Errorf("proposals mismatch: have %v, want 30, 10 and 0", proposed)
+	}
+
+	// Validators take turns rather than proposing their whole weight in a row
+	turns := map[common.Address]uint64{addr1: 2, addr2: 2, addr3: 1}
+	var order []common.Address
+	for sequence := uint64(0); sequence < 5; sequence++ {
+		valSet := newWeightedTestSet(turns, sequence, addr1, addr2, addr3)
+		valSet.CalcProposer(common.Address{}, 0)
+		order = append(order, valSet.GetProposer().Address())
+	}
+	if want := []common.Address{addr1, addr2, addr3, addr1, addr2}; !reflect.DeepEqual(order, want) {
+		t.Errorf("proposal order mismatch: have %v, want %v", order, want)
+	}
+
+	// Round changes move to the next validators whatever their weight
+	valSet := newWeightedTestSet(weights, 1, addr1, addr2, addr3)
+	for round, want := range []common.Address{addr2, addr3, addr1} {
+		valSet.CalcProposer(addr1, uint64(round))
+		if have := valSet.GetProposer().Address(); have != want {
+			t.Errorf("round %d: proposer mismatch: have %v, want %v", round, have, want)
+		}
+	}
+
+	// The selection survives copying the set
+	cpy := valSet.Copy()
+	cpy.CalcProposer(addr1, 0)
+	if have := cpy.GetProposer().Address(); have != addr2 {
+		t.Errorf("copy proposer mismatch: have %v, want %v", have, addr2)
+	}
+	if have, sequence := Weights(cpy); len(have) != 2 || sequence != 1 {
+		t.Errorf("copy weights mismatch: have %v at %d", have, sequence)
+	}
+
+	// Without any weight the set falls back to round robin
+	valSet = newWeightedTestSet(map[common.Address]uint64{addr1: 0, addr2: 0}, 7, addr1, addr2)
+	valSet.CalcProposer(addr1, 0)
+	if have := valSet.GetProposer().Address(); have != addr2 {
+		t.Errorf("fallback proposer mismatch: have %v, want %v", have, addr2)
+	}
+}
@@ -9,6 +9,14 @@ interface ETNPriorityTransactorsInterface {
         string name;
     }
 
+    struct TransactorLimits {
+        string publicKey;
+        uint64 maxPending;
+        uint64 maxBlockGas;
+        uint64 maxWindowGas;
+    }
+
     function getTransactors() external view returns (TransactorMeta[] memory);
     function getTransactorByKey(string memory _publicKey) external view returns (TransactorMeta memory);
+    function getTransactorLimits() external view returns (TransactorLimits[] memory);
 }
\ No newline at end of file
@@ -35,9 +35,17 @@ type ETNPriorityTransactorsInterfaceTransactorMeta struct {
 	Name             string
 }
 
+// ETNPriorityTransactorsInterfaceTransactorLimits is an auto generated low-level Go binding around an user-defined struct.
+type ETNPriorityTransactorsInterfaceTransactorLimits struct {
+	PublicKey    string
+	MaxPending   uint64
+	MaxBlockGas  uint64
+	MaxWindowGas uint64
+}
+
 // ETNPriorityTransactorsInterfaceMetaData contains all meta data concerning the ETNPriorityTransactorsInterface contract.
 var ETNPriorityTransactorsInterfaceMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_publicKey\",\"type\":\"string\"}],\"name\":\"getTransactorByKey\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"isGasPriceWaiver\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"publicKey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"}],\"internalType\":\"structETNPriorityTransactorsInterface.TransactorMeta\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTransactors\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"isGasPriceWaiver\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"publicKey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"}],\"internalType\":\"structETNPriorityTransactorsInterface.TransactorMeta[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_publicKey\",\"type\":\"string\"}],\"name\":\"getTransactorByKey\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"isGasPriceWaiver\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"publicKey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"}],\"internalType\":\"structETNPriorityTransactorsInterface.TransactorMeta\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTransactorLimits\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"publicKey\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"maxPending\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxBlockGas\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxWindowGas\",\"type\":\"uint64\"}],\"internalType\":\"structETNPriorityTransactorsInterface.TransactorLimits[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTransactors\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"isGasPriceWaiver\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"publicKey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"}],\"internalType\":\"structETNPriorityTransactorsInterface.TransactorMeta[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // ETNPriorityTransactorsInterface is an auto generated Go binding around an Ethereum contract.
@@ -213,6 +221,37 @@ func (_ETNPriorityTransactorsInterface *ETNPriorityTransactorsInterfaceCallerSes
 	return _ETNPriorityTransactorsInterface.Contract.GetTransactorByKey(&_ETNPriorityTransactorsInterface.CallOpts, _publicKey)
 }
 
+// GetTransactorLimits is a free data retrieval call binding the contract method 0xb3d19686.
+//
+// Solidity: function getTransactorLimits() view returns((string,uint64,uint64,uint64)[])
+func (_ETNPriorityTransactorsInterface *ETNPriorityTransactorsInterfaceCaller) GetTransactorLimits(opts *bind.CallOpts) ([]ETNPriorityTransactorsInterfaceTransactorLimits, error) {
+	var out []interface{}
+	err := _ETNPriorityTransactorsInterface.contract.Call(opts, &out, "getTransactorLimits")
+
+	if err != nil {
+		return *new([]ETNPriorityTransactorsInterfaceTransactorLimits), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]ETNPriorityTransactorsInterfaceTransactorLimits)).(*[]ETNPriorityTransactorsInterfaceTransactorLimits)
+
+	return out0, err
+
+}
+
+// GetTransactorLimits is a free data retrieval call binding the contract method 0xb3d19686.
+//
+// Solidity: function getTransactorLimits() view returns((string,uint64,uint64,uint64)[])
+func (_ETNPriorityTransactorsInterface *ETNPriorityTransactorsInterfaceSession) GetTransactorLimits() ([]ETNPriorityTransactorsInterfaceTransactorLimits, error) {
+	return _ETNPriorityTransactorsInterface.Contract.GetTransactorLimits(&_ETNPriorityTransactorsInterface.CallOpts)
+}
+
+// GetTransactorLimits is a free data retrieval call binding the contract method 0xb3d19686.
+//
+// Solidity: function getTransactorLimits() view returns((string,uint64,uint64,uint64)[])
+func (_ETNPriorityTransactorsInterface *ETNPriorityTransactorsInterfaceCallerSession) GetTransactorLimits() ([]ETNPriorityTransactorsInterfaceTransactorLimits, error) {
+	return _ETNPriorityTransactorsInterface.Contract.GetTransactorLimits(&_ETNPriorityTransactorsInterface.CallOpts)
+}
+
 // GetTransactors is a free data retrieval call binding the contract method 0x2d26b309.
 //
 // Solidity: function getTransactors() view returns((uint64,uint64,bool,string,string)[])
@@ -0,0 +1,174 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package prioritytransactors
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/electroneum/electroneum-sc/accounts/abi"
+	"github.com/electroneum/electroneum-sc/accounts/abi/bind"
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/common/math"
+	"github.com/electroneum/electroneum-sc/core/vm"
+)
+
+// Transactors converts the entries returned by the getTransactors method into a
+// transactor map, skipping the entries without a valid public key.
+func Transactors(metas []ETNPriorityTransactorsInterfaceTransactorMeta) common.PriorityTransactorMap {
+	transactors := make(common.PriorityTransactorMap)
+	for _, meta := range metas {
+		pkBytes := common.FromHex(meta.PublicKey)
+		if len(pkBytes) != common.PublicKeyLength {
+			continue
+		}
+		pk := common.BytesToPublicKey(pkBytes)
+		if !pk.IsValid() {
+			continue
+		}
+		transactors[pk] = common.PriorityTransactor{
+			IsGasPriceWaiver: meta.IsGasPriceWaiver,
+			EntityName:       meta.Name,
+		}
+	}
+	return transactors
+}
+
+// SetLimits applies the entries returned by the getTransactorLimits method to
+// the transactors they name. Entries for unknown transactors are ignored.
+func SetLimits(transactors common.PriorityTransactorMap, limits []ETNPriorityTransactorsInterfaceTransactorLimits) {
+	for _, limit := range limits {
+		pkBytes := common.FromHex(limit.PublicKey)
+		if len(pkBytes) != common.PublicKeyLength {
+			continue
+		}
+		pk := common.BytesToPublicKey(pkBytes)
+		transactor, ok := transactors[pk]
+		if !ok {
+			continue
+		}
+		transactor.Limits = common.PriorityTransactorLimits{
+			MaxPending:   limit.MaxPending,
+			MaxBlockGas:  limit.MaxBlockGas,
+			MaxWindowGas: limit.MaxWindowGas,
+		}
+		transactors[pk] = transactor
+	}
+}
+
+// stubDispatchLength is the length of the code preceding the return data in the
+// contract emitted by StubCode.
+const stubDispatchLength = 43
+
+// TransactorsAt returns the priority transactors registered in the given contract
+// at the given block, as seen by the backend, e.g. an ethclient.Client. The block
+// number can be nil, in which case the transactors are read from the latest known
+// block.
+func TransactorsAt(ctx context.Context, backend bind.ContractCaller, contract common.Address, blockNumber *big.Int) (common.PriorityTransactorMap, error) {
+	caller, err := NewETNPriorityTransactorsInterfaceCaller(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	metas, err := caller.GetTransactors(opts)
+	if err != nil {
+		return nil, err
+	}
+	transactors := Transactors(metas)
+
+	// Contracts predating transactor limits don't implement getTransactorLimits
+	if limits, err := caller.GetTransactorLimits(opts); err == nil {
+		SetLimits(transactors, limits)
+	}
+	return transactors, nil
+}
+
+// StubCode returns the runtime code of a contract answering the getTransactorLimits
+// method with the limits of the given transactors, and any other call with the
+// transactors themselves, as the getTransactors method would. It stands in for
+// the real contract on test chains.
+func StubCode(transactors common.PriorityTransactorMap) ([]byte, error) {
+	contractABI, err := abi.JSON(strings.NewReader(ETNPriorityTransactorsInterfaceMetaData.ABI))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		metas  = make([]ETNPriorityTransactorsInterfaceTransactorMeta, 0, len(transactors))
+		limits = make([]ETNPriorityTransactorsInterfaceTransactorLimits, 0, len(transactors))
+	)
+	for pubkey, transactor := range transactors {
+		metas = append(metas, ETNPriorityTransactorsInterfaceTransactorMeta{
+			IsGasPriceWaiver: transactor.IsGasPriceWaiver,
+			PublicKey:        hexutil.Encode(pubkey.Bytes()),
+			Name:             transactor.EntityName,
+		})
+		limits = append(limits, ETNPriorityTransactorsInterfaceTransactorLimits{
+			PublicKey:    hexutil.Encode(pubkey.Bytes()),
+			MaxPending:   transactor.Limits.MaxPending,
+			MaxBlockGas:  transactor.Limits.MaxBlockGas,
+			MaxWindowGas: transactor.Limits.MaxWindowGas,
+		})
+	}
+	metasRet, err := contractABI.Methods["getTransactors"].Outputs.Pack(metas)
+	if err != nil {
+		return nil, err
+	}
+	limitsRet, err := contractABI.Methods["getTransactorLimits"].Outputs.Pack(limits)
+	if err != nil {
+		return nil, err
+	}
+	if stubDispatchLength+len(metasRet)+len(limitsRet) > math.MaxUint16 {
+		return nil, errors.New("too many priority transactors")
+	}
+	var (
+		selector    = contractABI.Methods["getTransactorLimits"].ID
+		metasOffset = stubDispatchLength
+		limitOffset = metasOffset + len(metasRet)
+	)
+	// Dispatch on the selector, then copy the return data appended to the
+	// code into memory and return it
+	code := []byte{
+		byte(vm.PUSH1), 0x00,
+		byte(vm.CALLDATALOAD),
+		byte(vm.PUSH1), 0xe0,
+		byte(vm.SHR),
+		byte(vm.PUSH4), selector[0], selector[1], selector[2], selector[3],
+		byte(vm.EQ),
+		byte(vm.PUSH2), 0x00, 0x1d,
+		byte(vm.JUMPI),
+		byte(vm.PUSH2), byte(len(metasRet) >> 8), byte(len(metasRet)),
+		byte(vm.DUP1),
+		byte(vm.PUSH2), byte(metasOffset >> 8), byte(metasOffset),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.RETURN),
+		byte(vm.JUMPDEST),
+		byte(vm.PUSH2), byte(len(limitsRet) >> 8), byte(len(limitsRet)),
+		byte(vm.DUP1),
+		byte(vm.PUSH2), byte(limitOffset >> 8), byte(limitOffset),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.RETURN),
+	}
+	code = append(code, metasRet...)
+	return append(code, limitsRet...), nil
+}
@@ -0,0 +1,7 @@
+// contracts/ETNProposerWeightsInterface.sol
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.16;
+
+interface ETNProposerWeightsInterface {
+    function getProposerWeights(address[] calldata validators) external view returns (uint256[] memory);
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package proposerweights is the interface of the on-chain IBFT proposer weights contract.
+package proposerweights
+
+//go:generate solc --abi --bin -o . --overwrite ./contract/ETNProposerWeightsInterface.sol
+//go:generate go run ../../cmd/abigen -pkg proposerweights -abi ./ETNProposerWeightsInterface.abi -bin ./ETNProposerWeightsInterface.bin -type ETNProposerWeightsInterface -out ./proposerweights.go
+//go:generate rm ETNProposerWeightsInterface.abi ETNProposerWeightsInterface.bin
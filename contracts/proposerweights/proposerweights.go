@@ -0,0 +1,211 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package proposerweights
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	electroneum "github.com/electroneum/electroneum-sc"
+	"github.com/electroneum/electroneum-sc/accounts/abi"
+	"github.com/electroneum/electroneum-sc/accounts/abi/bind"
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = electroneum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ETNProposerWeightsInterfaceMetaData contains all meta data concerning the ETNProposerWeightsInterface contract.
+var ETNProposerWeightsInterfaceMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"}],\"name\":\"getProposerWeights\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ETNProposerWeightsInterfaceABI is the input ABI used to generate the binding from.
+// Deprecated: Use ETNProposerWeightsInterfaceMetaData.ABI instead.
+var ETNProposerWeightsInterfaceABI = ETNProposerWeightsInterfaceMetaData.ABI
+
+// ETNProposerWeightsInterface is an auto generated Go binding around an Ethereum contract.
+type ETNProposerWeightsInterface struct {
+	ETNProposerWeightsInterfaceCaller     // Read-only binding to the contract
+	ETNProposerWeightsInterfaceTransactor // Write-only binding to the contract
+	ETNProposerWeightsInterfaceFilterer   // Log filterer for contract events
+}
+
+// ETNProposerWeightsInterfaceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ETNProposerWeightsInterfaceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ETNProposerWeightsInterfaceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ETNProposerWeightsInterfaceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ETNProposerWeightsInterfaceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ETNProposerWeightsInterfaceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ETNProposerWeightsInterfaceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ETNProposerWeightsInterfaceSession struct {
+	Contract     *ETNProposerWeightsInterface // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// ETNProposerWeightsInterfaceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ETNProposerWeightsInterfaceCallerSession struct {
+	Contract *ETNProposerWeightsInterfaceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// ETNProposerWeightsInterfaceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ETNProposerWeightsInterfaceTransactorSession struct {
+	Contract     *ETNProposerWeightsInterfaceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// ETNProposerWeightsInterfaceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ETNProposerWeightsInterfaceRaw struct {
+	Contract *ETNProposerWeightsInterface // Generic contract binding to access the raw methods on
+}
+
+// ETNProposerWeightsInterfaceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ETNProposerWeightsInterfaceCallerRaw struct {
+	Contract *ETNProposerWeightsInterfaceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ETNProposerWeightsInterfaceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ETNProposerWeightsInterfaceTransactorRaw struct {
+	Contract *ETNProposerWeightsInterfaceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewETNProposerWeightsInterface creates a new instance of ETNProposerWeightsInterface, bound to a specific deployed contract.
+func NewETNProposerWeightsInterface(address common.Address, backend bind.ContractBackend) (*ETNProposerWeightsInterface, error) {
+	contract, err := bindETNProposerWeightsInterface(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNProposerWeightsInterface{ETNProposerWeightsInterfaceCaller: ETNProposerWeightsInterfaceCaller{contract: contract}, ETNProposerWeightsInterfaceTransactor: ETNProposerWeightsInterfaceTransactor{contract: contract}, ETNProposerWeightsInterfaceFilterer: ETNProposerWeightsInterfaceFilterer{contract: contract}}, nil
+}
+
+// NewETNProposerWeightsInterfaceCaller creates a new read-only instance of ETNProposerWeightsInterface, bound to a specific deployed contract.
+func NewETNProposerWeightsInterfaceCaller(address common.Address, caller bind.ContractCaller) (*ETNProposerWeightsInterfaceCaller, error) {
+	contract, err := bindETNProposerWeightsInterface(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNProposerWeightsInterfaceCaller{contract: contract}, nil
+}
+
+// NewETNProposerWeightsInterfaceTransactor creates a new write-only instance of ETNProposerWeightsInterface, bound to a specific deployed contract.
+func NewETNProposerWeightsInterfaceTransactor(address common.Address, transactor bind.ContractTransactor) (*ETNProposerWeightsInterfaceTransactor, error) {
+	contract, err := bindETNProposerWeightsInterface(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNProposerWeightsInterfaceTransactor{contract: contract}, nil
+}
+
+// NewETNProposerWeightsInterfaceFilterer creates a new log filterer instance of ETNProposerWeightsInterface, bound to a specific deployed contract.
+func NewETNProposerWeightsInterfaceFilterer(address common.Address, filterer bind.ContractFilterer) (*ETNProposerWeightsInterfaceFilterer, error) {
+	contract, err := bindETNProposerWeightsInterface(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNProposerWeightsInterfaceFilterer{contract: contract}, nil
+}
+
+// bindETNProposerWeightsInterface binds a generic wrapper to an already deployed contract.
+func bindETNProposerWeightsInterface(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ETNProposerWeightsInterfaceABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ETNProposerWeightsInterface.Contract.ETNProposerWeightsInterfaceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ETNProposerWeightsInterface.Contract.ETNProposerWeightsInterfaceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ETNProposerWeightsInterface.Contract.ETNProposerWeightsInterfaceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ETNProposerWeightsInterface.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ETNProposerWeightsInterface.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ETNProposerWeightsInterface.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetProposerWeights is a free data retrieval call binding the contract method 0x6deacd9a.
+//
+// Solidity: function getProposerWeights(address[] validators) view returns(uint256[])
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceCaller) GetProposerWeights(opts *bind.CallOpts, validators []common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _ETNProposerWeightsInterface.contract.Call(opts, &out, "getProposerWeights", validators)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetProposerWeights is a free data retrieval call binding the contract method 0x6deacd9a.
+//
+// Solidity: function getProposerWeights(address[] validators) view returns(uint256[])
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceSession) GetProposerWeights(validators []common.Address) ([]*big.Int, error) {
+	return _ETNProposerWeightsInterface.Contract.GetProposerWeights(&_ETNProposerWeightsInterface.CallOpts, validators)
+}
+
+// GetProposerWeights is a free data retrieval call binding the contract method 0x6deacd9a.
+//
+// Solidity: function getProposerWeights(address[] validators) view returns(uint256[])
+func (_ETNProposerWeightsInterface *ETNProposerWeightsInterfaceCallerSession) GetProposerWeights(validators []common.Address) ([]*big.Int, error) {
+	return _ETNProposerWeightsInterface.Contract.GetProposerWeights(&_ETNProposerWeightsInterface.CallOpts, validators)
+}
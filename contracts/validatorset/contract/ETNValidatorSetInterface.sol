@@ -0,0 +1,7 @@
+// contracts/ETNValidatorSetInterface.sol
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.16;
+
+interface ETNValidatorSetInterface {
+    function getValidators() external view returns (address[] memory);
+}
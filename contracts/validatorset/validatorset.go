@@ -0,0 +1,211 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package validatorset
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	electroneum "github.com/electroneum/electroneum-sc"
+	"github.com/electroneum/electroneum-sc/accounts/abi"
+	"github.com/electroneum/electroneum-sc/accounts/abi/bind"
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = electroneum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ETNValidatorSetInterfaceMetaData contains all meta data concerning the ETNValidatorSetInterface contract.
+var ETNValidatorSetInterfaceMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ETNValidatorSetInterfaceABI is the input ABI used to generate the binding from.
+// Deprecated: Use ETNValidatorSetInterfaceMetaData.ABI instead.
+var ETNValidatorSetInterfaceABI = ETNValidatorSetInterfaceMetaData.ABI
+
+// ETNValidatorSetInterface is an auto generated Go binding around an Ethereum contract.
+type ETNValidatorSetInterface struct {
+	ETNValidatorSetInterfaceCaller     // Read-only binding to the contract
+	ETNValidatorSetInterfaceTransactor // Write-only binding to the contract
+	ETNValidatorSetInterfaceFilterer   // Log filterer for contract events
+}
+
+// ETNValidatorSetInterfaceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ETNValidatorSetInterfaceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ETNValidatorSetInterfaceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ETNValidatorSetInterfaceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ETNValidatorSetInterfaceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ETNValidatorSetInterfaceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ETNValidatorSetInterfaceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ETNValidatorSetInterfaceSession struct {
+	Contract     *ETNValidatorSetInterface // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ETNValidatorSetInterfaceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ETNValidatorSetInterfaceCallerSession struct {
+	Contract *ETNValidatorSetInterfaceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// ETNValidatorSetInterfaceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ETNValidatorSetInterfaceTransactorSession struct {
+	Contract     *ETNValidatorSetInterfaceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// ETNValidatorSetInterfaceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ETNValidatorSetInterfaceRaw struct {
+	Contract *ETNValidatorSetInterface // Generic contract binding to access the raw methods on
+}
+
+// ETNValidatorSetInterfaceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ETNValidatorSetInterfaceCallerRaw struct {
+	Contract *ETNValidatorSetInterfaceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ETNValidatorSetInterfaceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ETNValidatorSetInterfaceTransactorRaw struct {
+	Contract *ETNValidatorSetInterfaceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewETNValidatorSetInterface creates a new instance of ETNValidatorSetInterface, bound to a specific deployed contract.
+func NewETNValidatorSetInterface(address common.Address, backend bind.ContractBackend) (*ETNValidatorSetInterface, error) {
+	contract, err := bindETNValidatorSetInterface(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNValidatorSetInterface{ETNValidatorSetInterfaceCaller: ETNValidatorSetInterfaceCaller{contract: contract}, ETNValidatorSetInterfaceTransactor: ETNValidatorSetInterfaceTransactor{contract: contract}, ETNValidatorSetInterfaceFilterer: ETNValidatorSetInterfaceFilterer{contract: contract}}, nil
+}
+
+// NewETNValidatorSetInterfaceCaller creates a new read-only instance of ETNValidatorSetInterface, bound to a specific deployed contract.
+func NewETNValidatorSetInterfaceCaller(address common.Address, caller bind.ContractCaller) (*ETNValidatorSetInterfaceCaller, error) {
+	contract, err := bindETNValidatorSetInterface(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNValidatorSetInterfaceCaller{contract: contract}, nil
+}
+
+// NewETNValidatorSetInterfaceTransactor creates a new write-only instance of ETNValidatorSetInterface, bound to a specific deployed contract.
+func NewETNValidatorSetInterfaceTransactor(address common.Address, transactor bind.ContractTransactor) (*ETNValidatorSetInterfaceTransactor, error) {
+	contract, err := bindETNValidatorSetInterface(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNValidatorSetInterfaceTransactor{contract: contract}, nil
+}
+
+// NewETNValidatorSetInterfaceFilterer creates a new log filterer instance of ETNValidatorSetInterface, bound to a specific deployed contract.
+func NewETNValidatorSetInterfaceFilterer(address common.Address, filterer bind.ContractFilterer) (*ETNValidatorSetInterfaceFilterer, error) {
+	contract, err := bindETNValidatorSetInterface(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ETNValidatorSetInterfaceFilterer{contract: contract}, nil
+}
+
+// bindETNValidatorSetInterface binds a generic wrapper to an already deployed contract.
+func bindETNValidatorSetInterface(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ETNValidatorSetInterfaceABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ETNValidatorSetInterface.Contract.ETNValidatorSetInterfaceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ETNValidatorSetInterface.Contract.ETNValidatorSetInterfaceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ETNValidatorSetInterface.Contract.ETNValidatorSetInterfaceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ETNValidatorSetInterface.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ETNValidatorSetInterface.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ETNValidatorSetInterface.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceCaller) GetValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _ETNValidatorSetInterface.contract.Call(opts, &out, "getValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceSession) GetValidators() ([]common.Address, error) {
+	return _ETNValidatorSetInterface.Contract.GetValidators(&_ETNValidatorSetInterface.CallOpts)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_ETNValidatorSetInterface *ETNValidatorSetInterfaceCallerSession) GetValidators() ([]common.Address, error) {
+	return _ETNValidatorSetInterface.Contract.GetValidators(&_ETNValidatorSetInterface.CallOpts)
+}
@@ -99,7 +99,6 @@ func (b *BlockGen) AddTx(tx *types.Transaction) {
 // further limitations on the content of transactions that can be
 // added. If contract code relies on the BLOCKHASH instruction,
 // the block in chain will be returned.
-// todo: set priority transactors before this point before future tests that send priority tx
 func (b *BlockGen) AddTxWithChain(bc *BlockChain, tx *types.Transaction) {
 	if b.gasPool == nil {
 		b.SetCoinbase(common.Address{})
@@ -258,6 +257,11 @@ func GenerateChain(config *params.ChainConfig, parent *types.Block, engine conse
 		if config.DAOForkSupport && config.DAOForkBlock != nil && config.DAOForkBlock.Cmp(b.header.Number) == 0 {
 			misc.ApplyDAOHardFork(statedb)
 		}
+		// Load the priority transactors allowed in the block, just like block
+		// processing does. The contract can't rely on BLOCKHASH here.
+		vmenv := vm.NewEVM(NewEVMBlockContext(b.header, nil, &b.header.Coinbase), vm.TxContext{}, statedb, config, vm.Config{})
+		statedb.SetPriorityTransactors(GetPriorityTransactors(vmenv))
+
 		// Execute any user modifications to the block
 		if gen != nil {
 			gen(i, b)
@@ -0,0 +1,224 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"sync"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/params"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// priorityGasCacheLimit is the number of blocks whose waived gas is cached.
+const priorityGasCacheLimit = 1024
+
+// PriorityQuotaConfig are the local limits on the gas price waived transactions
+// of every priority transactor. A zero limit is not enforced.
+type PriorityQuotaConfig struct {
+	MaxPending   uint64 // Maximum number of waived transactions per transactor in the pool
+	MaxBlockGas  uint64 // Maximum gas of waived transactions per transactor in a block
+	MaxWindowGas uint64 // Maximum gas of waived transactions per transactor within the window
+	Window       uint64 // Number of blocks in the rolling window, including the one being built
+}
+
+// DefaultPriorityQuotaConfig contains the default limits on the gas price waived
+// transactions of priority transactors.
+var DefaultPriorityQuotaConfig = PriorityQuotaConfig{
+	MaxPending: 1024,
+	Window:     720, // an hour of 5 second blocks
+}
+
+// Limits returns the limits applying to the given priority transactor, the
+// stricter of the local ones and the ones registered in the priority contract.
+func (config *PriorityQuotaConfig) Limits(transactor common.PriorityTransactor) common.PriorityTransactorLimits {
+	return common.PriorityTransactorLimits{
+		MaxPending:   minLimit(config.MaxPending, transactor.Limits.MaxPending),
+		MaxBlockGas:  minLimit(config.MaxBlockGas, transactor.Limits.MaxBlockGas),
+		MaxWindowGas: minLimit(config.MaxWindowGas, transactor.Limits.MaxWindowGas),
+	}
+}
+
+// minLimit returns the stricter of two limits, zero meaning no limit.
+func minLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// priorityGasReader is the chain access needed to look up the waived gas of
+// recent blocks, and the priority transactors the blocks were built with.
+type priorityGasReader interface {
+	GetBlock(hash common.Hash, number uint64) *types.Block
+	GetReceiptsByHash(hash common.Hash) types.Receipts
+	StateAt(root common.Hash) (*state.StateDB, error)
+	GetPriorityTransactorsForState(header *types.Header, state *state.StateDB) common.PriorityTransactorMap
+}
+
+// priorityBlockGas is the waived gas per priority transactor of a single block.
+type priorityBlockGas struct {
+	parent common.Hash
+	gas    map[common.PublicKey]uint64
+}
+
+// PriorityGasTracker accounts the gas used by the gas price waived transactions
+// included in recent blocks, per priority transactor. The totals of the rolling
+// window are kept up to date as the chain advances, and only recomputed from the
+// blocks on reorgs or window changes.
+type PriorityGasTracker struct {
+	config *params.ChainConfig
+	cache  *lru.Cache // Waived gas of recent blocks, keyed by block hash
+
+	lock   sync.Mutex
+	head   common.Hash                 // Last block of the tracked window
+	window uint64                      // Window the totals were computed for
+	blocks []*priorityBlockGas         // Waived gas of the blocks in the window, oldest first
+	totals map[common.PublicKey]uint64 // Waived gas within the window per priority transactor
+}
+
+// NewPriorityGasTracker creates a tracker of the waived gas of recent blocks.
+func NewPriorityGasTracker(config *params.ChainConfig) *PriorityGasTracker {
+	cache, _ := lru.New(priorityGasCacheLimit)
+	return &PriorityGasTracker{
+		config: config,
+		cache:  cache,
+	}
+}
+
+// WindowGas returns the waived gas per priority transactor of the blocks which
+// share the rolling window with the child of head, i.e. head and its ancestors
+// up to window-1 blocks in total.
+func (t *PriorityGasTracker) WindowGas(chain priorityGasReader, head *types.Header, window uint64) map[common.PublicKey]uint64 {
+	if window <= 1 {
+		return make(map[common.PublicKey]uint64)
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	hash, number := head.Hash(), head.Number.Uint64()
+	switch {
+	case t.totals != nil && t.window == window && t.head == hash:
+		// Nothing changed since the last lookup
+	case t.totals != nil && t.window == window && t.head == head.ParentHash:
+		t.advance(chain, hash, number)
+	default:
+		t.rebuild(chain, hash, number, window)
+	}
+	used := make(map[common.PublicKey]uint64, len(t.totals))
+	for pubkey, gas := range t.totals {
+		used[pubkey] = gas
+	}
+	return used
+}
+
+// advance slides the window onto the given child of its last block.
+func (t *PriorityGasTracker) advance(chain priorityGasReader, hash common.Hash, number uint64) {
+	blockGas := t.blockGas(chain, hash, number)
+	if blockGas == nil {
+		t.rebuild(chain, hash, number, t.window)
+		return
+	}
+	t.head = hash
+	t.blocks = append(t.blocks, blockGas)
+	for pubkey, gas := range blockGas.gas {
+		t.totals[pubkey] += gas
+	}
+	for uint64(len(t.blocks)) > t.window-1 {
+		for pubkey, gas := range t.blocks[0].gas {
+			if t.totals[pubkey] -= gas; t.totals[pubkey] == 0 {
+				delete(t.totals, pubkey)
+			}
+		}
+		t.blocks[0] = nil
+		t.blocks = t.blocks[1:]
+	}
+}
+
+// rebuild recomputes the window ending with the given block from the chain.
+func (t *PriorityGasTracker) rebuild(chain priorityGasReader, hash common.Hash, number uint64, window uint64) {
+	t.head, t.window = hash, window
+	t.blocks, t.totals = nil, make(map[common.PublicKey]uint64)
+
+	for i := uint64(1); i < window; i++ {
+		blockGas := t.blockGas(chain, hash, number)
+		if blockGas == nil {
+			break
+		}
+		t.blocks = append(t.blocks, blockGas)
+		for pubkey, gas := range blockGas.gas {
+			t.totals[pubkey] += gas
+		}
+		if number == 0 {
+			break
+		}
+		hash, number = blockGas.parent, number-1
+	}
+	// Blocks were collected from the newest, keep them oldest first
+	for i, j := 0, len(t.blocks)-1; i < j; i, j = i+1, j-1 {
+		t.blocks[i], t.blocks[j] = t.blocks[j], t.blocks[i]
+	}
+}
+
+// blockGas returns the waived gas per priority transactor of the given block,
+// or nil if the block or its receipts are unknown.
+func (t *PriorityGasTracker) blockGas(chain priorityGasReader, hash common.Hash, number uint64) *priorityBlockGas {
+	if cached, ok := t.cache.Get(hash); ok {
+		return cached.(*priorityBlockGas)
+	}
+	block := chain.GetBlock(hash, number)
+	if block == nil {
+		return nil
+	}
+	txs := block.Transactions()
+	receipts := chain.GetReceiptsByHash(hash)
+	if len(receipts) != len(txs) {
+		return nil
+	}
+	var (
+		signer   = types.MakeSigner(t.config, block.Number())
+		blockGas = &priorityBlockGas{
+			parent: block.ParentHash(),
+			gas:    make(map[common.PublicKey]uint64),
+		}
+	)
+	lookup := PriorityTransactorLookup(func() common.PriorityTransactorMap {
+		parent := chain.GetBlock(block.ParentHash(), number-1)
+		if parent == nil {
+			return nil
+		}
+		statedb, err := chain.StateAt(parent.Root())
+		if err != nil {
+			return nil
+		}
+		return chain.GetPriorityTransactorsForState(block.Header(), statedb)
+	})
+	for i, tx := range txs {
+		if tx.Type() != types.PriorityTxType || !tx.HasZeroFee() {
+			continue
+		}
+		pubkey, err := ResolvePriorityKey(t.config, block.Number(), signer, tx, lookup)
+		if err != nil {
+			continue
+		}
+		blockGas.gas[pubkey] += receipts[i].GasUsed
+	}
+	t.cache.Add(hash, blockGas)
+	return blockGas
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/trie"
+)
+
+// Tests that the limits of a priority transactor are the stricter of the local
+// and the contract ones, zero meaning no limit.
+func TestPriorityQuotaLimits(t *testing.T) {
+	config := PriorityQuotaConfig{MaxPending: 16, MaxBlockGas: 1_000_000}
+	transactor := common.PriorityTransactor{
+		IsGasPriceWaiver: true,
+		Limits:           common.PriorityTransactorLimits{MaxPending: 32, MaxBlockGas: 500_000, MaxWindowGas: 5_000_000},
+	}
+	want := common.PriorityTransactorLimits{MaxPending: 16, MaxBlockGas: 500_000, MaxWindowGas: 5_000_000}
+	if have := config.Limits(transactor); have != want {
+		t.Fatalf("limits mismatch: have %+v, want %+v", have, want)
+	}
+	if have := config.Limits(common.PriorityTransactor{}); have != (common.PriorityTransactorLimits{MaxPending: 16, MaxBlockGas: 1_000_000}) {
+		t.Fatalf("local limits mismatch: have %+v", have)
+	}
+}
+
+// testGasReader is a chain of blocks and receipts looked up by hash, built with
+// a fixed set of priority transactors.
+type testGasReader struct {
+	blocks      map[common.Hash]*types.Block
+	receipts    map[common.Hash]types.Receipts
+	transactors common.PriorityTransactorMap
+}
+
+func (r *testGasReader) GetBlock(hash common.Hash, number uint64) *types.Block {
+	return r.blocks[hash]
+}
+
+func (r *testGasReader) GetReceiptsByHash(hash common.Hash) types.Receipts {
+	return r.receipts[hash]
+}
+
+func (r *testGasReader) StateAt(root common.Hash) (*state.StateDB, error) {
+	return nil, nil
+}
+
+func (r *testGasReader) GetPriorityTransactorsForState(header *types.Header, state *state.StateDB) common.PriorityTransactorMap {
+	return r.transactors
+}
+
+// add creates a child of parent with a waived transaction of the given priority
+// key using gasUsed gas.
+func (r *testGasReader) add(parent *types.Header, priorityKey *ecdsa.PrivateKey, gasUsed uint64) *types.Header {
+	key, _ := crypto.GenerateKey()
+	header := &types.Header{ParentHash: parent.Hash(), Number: new(big.Int).Add(parent.Number, common.Big1)}
+	txs := types.Transactions{priorityTx(0, 100_000, new(big.Int), new(big.Int), key, priorityKey)}
+	receipts := types.Receipts{{GasUsed: gasUsed}}
+	block := types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+
+	r.blocks[block.Hash()] = block
+	r.receipts[block.Hash()] = receipts
+	return block.Header()
+}
+
+// Tests that the waived gas of the rolling window follows the chain by the gas
+// used, across window slides and reorgs.
+func TestPriorityGasTrackerWindow(t *testing.T) {
+	var (
+		reader  = &testGasReader{blocks: make(map[common.Hash]*types.Block), receipts: make(map[common.Hash]types.Receipts)}
+		genesis = &types.Header{Number: new(big.Int)}
+		tracker = NewPriorityGasTracker(params.TestChainConfig)
+		pubkey  = common.BytesToPublicKey(crypto.FromECDSAPub(&priorityPrivateKeys[0].PublicKey))
+	)
+	reader.blocks[genesis.Hash()] = types.NewBlockWithHeader(genesis)
+
+	// A window of 4 blocks covers the head and its two parents
+	head := genesis
+	for i, want := range []uint64{1000, 3000, 6000, 9000, 12000} {
+		head = reader.add(head, priorityPrivateKeys[0], uint64(i+1)*1000)
+		used := tracker.WindowGas(reader, head, 4)
+		if used[pubkey] != want {
+			t.Fatalf("block %d: window gas mismatch: have %d, want %d", i+1, used[pubkey], want)
+		}
+		// The returned totals belong to the caller
+		used[pubkey] = 0
+	}
+	// A sibling of the head replaces its gas in the window
+	sibling := reader.add(reader.blocks[head.ParentHash].Header(), priorityPrivateKeys[0], 100)
+	if used := tracker.WindowGas(reader, sibling, 4); used[pubkey] != 7100 {
+		t.Fatalf("reorg window gas mismatch: have %d, want %d", used[pubkey], 7100)
+	}
+	if used := tracker.WindowGas(reader, head, 2); used[pubkey] != 5000 {
+		t.Fatalf("resized window gas mismatch: have %d, want %d", used[pubkey], 5000)
+	}
+	if used := tracker.WindowGas(reader, head, 0); len(used) != 0 {
+		t.Fatalf("disabled window gas mismatch: have %v", used)
+	}
+}
+
+// Tests that the waived gas of a transaction priority signed under the other
+// scheme within the grace window is accounted to the transactor it resolves to
+// in block processing, not to the key recovered under the block signer.
+func TestPriorityGasTrackerGraceWindow(t *testing.T) {
+	config := *params.TestChainConfig
+	config.FutureForkBlock = big.NewInt(0)
+	config.PrioritySigGraceBlocks = 2
+
+	var (
+		pubkey  = common.BytesToPublicKey(crypto.FromECDSAPub(&priorityPrivateKeys[0].PublicKey))
+		reader  = &testGasReader{blocks: make(map[common.Hash]*types.Block), receipts: make(map[common.Hash]types.Receipts), transactors: common.PriorityTransactorMap{pubkey: {IsGasPriceWaiver: true}}}
+		genesis = &types.Header{Number: new(big.Int)}
+		tracker = NewPriorityGasTracker(&config)
+	)
+	reader.blocks[genesis.Hash()] = types.NewBlockWithHeader(genesis)
+
+	// The test transactions carry a pre-fork priority signature
+	head := reader.add(genesis, priorityPrivateKeys[0], 1000)
+	used := tracker.WindowGas(reader, head, 4)
+	if used[pubkey] != 1000 || len(used) != 1 {
+		t.Fatalf("window gas mismatch: have %v, want %d for %x", used, 1000, pubkey)
+	}
+}
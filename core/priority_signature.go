@@ -0,0 +1,169 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/params"
+)
+
+// ResolvePrioritySender recovers the priority public key of a priority
+// transaction in the block of the given number, along with its transactor as
+// returned by lookup and whether it was found.
+//
+// The key is recovered under the priority signature scheme of the signer.
+// Within the priority signature grace window around the future fork, a key
+// recovered under the other scheme is accepted too if it belongs to a known
+// transactor while the first one does not, so that transactions signed
+// shortly before or after the fork stay valid across it.
+func ResolvePrioritySender(config *params.ChainConfig, number *big.Int, signer types.Signer, tx *types.Transaction, lookup func(common.PublicKey) (common.PriorityTransactor, bool)) (common.PublicKey, common.PriorityTransactor, bool, error) {
+	pubkey, err := types.PrioritySender(signer, tx)
+	if err != nil {
+		return common.PublicKey{}, common.PriorityTransactor{}, false, err
+	}
+	if transactor, ok := lookup(pubkey); ok || !config.IsPrioritySigGrace(number) {
+		return pubkey, transactor, ok, nil
+	}
+	if alternate := types.AlternatePrioritySigner(signer); alternate != nil {
+		if altPubkey, err := types.PrioritySender(alternate, tx); err == nil {
+			if transactor, ok := lookup(altPubkey); ok {
+				return altPubkey, transactor, true, nil
+			}
+		}
+	}
+	return pubkey, common.PriorityTransactor{}, false, nil
+}
+
+// ResolvePriorityKey returns the priority public key of a priority transaction
+// in the block of the given number, as resolved by ResolvePrioritySender. The
+// key only depends on the transactors within the priority signature grace
+// window, so lookup is not called outside of it.
+func ResolvePriorityKey(config *params.ChainConfig, number *big.Int, signer types.Signer, tx *types.Transaction, lookup func(common.PublicKey) (common.PriorityTransactor, bool)) (common.PublicKey, error) {
+	if !config.IsPrioritySigGrace(number) {
+		return types.PrioritySender(signer, tx)
+	}
+	pubkey, _, _, err := ResolvePrioritySender(config, number, signer, tx, lookup)
+	return pubkey, err
+}
+
+// PriorityTransactorLookup returns a lookup into the priority transactors
+// returned by load, which is only called on the first lookup. It suits readers
+// which only need the transactors to resolve keys within the grace window.
+func PriorityTransactorLookup(load func() common.PriorityTransactorMap) func(common.PublicKey) (common.PriorityTransactor, bool) {
+	var (
+		once        sync.Once
+		transactors common.PriorityTransactorMap
+	)
+	return func(pubkey common.PublicKey) (common.PriorityTransactor, bool) {
+		once.Do(func() { transactors = load() })
+		transactor, ok := transactors[pubkey]
+		return transactor, ok
+	}
+}
+
+// TransactionToMessage converts a transaction of the block with the given
+// header into a message, resolving the priority sender of a priority
+// transaction against the transactors returned by lookup as block processing
+// does.
+func TransactionToMessage(config *params.ChainConfig, header *types.Header, tx *types.Transaction, lookup func(common.PublicKey) (common.PriorityTransactor, bool)) (types.Message, error) {
+	signer := types.MakeSigner(config, header.Number)
+	msg, err := tx.AsMessage(signer, header.BaseFee)
+	if err != nil || tx.Type() != types.PriorityTxType {
+		return msg, err
+	}
+	pubkey, err := ResolvePriorityKey(config, header.Number, signer, tx, lookup)
+	if err != nil {
+		return msg, err
+	}
+	return msg.WithPrioritySender(pubkey), nil
+}
+
+// PriorityWaiver returns the priority public key of a gas price waived priority
+// transaction in the block of the given number, resolved as by
+// ResolvePrioritySender, and whether the transaction is one.
+func PriorityWaiver(config *params.ChainConfig, number *big.Int, signer types.Signer, tx *types.Transaction, lookup func(common.PublicKey) (common.PriorityTransactor, bool)) (common.PublicKey, bool) {
+	if tx.Type() != types.PriorityTxType || !tx.HasZeroFee() {
+		return common.PublicKey{}, false
+	}
+	pubkey, _, _, err := ResolvePrioritySender(config, number, signer, tx, lookup)
+	if err != nil {
+		return common.PublicKey{}, false
+	}
+	return pubkey, true
+}
+
+// PriorityMigration reports the priority signature schemes under which a pooled
+// priority transaction resolves to a known priority transactor.
+type PriorityMigration struct {
+	Tx      *types.Transaction
+	Pending bool              // Whether the transaction is executable or queued
+	Legacy  *common.PublicKey // Key recovered under the pre-fork scheme, if a known transactor
+	Bound   *common.PublicKey // Key recovered under the sender-bound scheme, if a known transactor
+}
+
+// Affected returns whether the transaction is only valid under the pre-fork
+// priority signature scheme, and so will be rejected once the future fork and
+// its grace window have passed unless its priority signature is renewed.
+func (m *PriorityMigration) Affected() bool {
+	return m.Legacy != nil && m.Bound == nil
+}
+
+// PriorityMigration checks every priority transaction in the pool against the
+// current priority transactor set under both priority signature schemes, to
+// find the ones needing a new priority signature across the future fork.
+func (pool *TxPool) PriorityMigration() []*PriorityMigration {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	var (
+		legacy = types.NewLondonSigner(pool.chainconfig.ChainID)
+		bound  = types.NewFutureForkSigner(pool.chainconfig.ChainID)
+		report []*PriorityMigration
+	)
+	resolve := func(signer types.Signer, tx *types.Transaction) *common.PublicKey {
+		pubkey, err := types.PrioritySender(signer, tx)
+		if err != nil {
+			return nil
+		}
+		if _, ok := pool.currentPriorityTransactors[pubkey]; !ok {
+			return nil
+		}
+		return &pubkey
+	}
+	collect := func(lists map[common.Address]*txList, pending bool) {
+		for _, list := range lists {
+			for _, tx := range list.Flatten() {
+				if !IsPriorityTransaction(tx) {
+					continue
+				}
+				report = append(report, &PriorityMigration{
+					Tx:      tx,
+					Pending: pending,
+					Legacy:  resolve(legacy, tx),
+					Bound:   resolve(bound, tx),
+				})
+			}
+		}
+	}
+	collect(pool.pending, true)
+	collect(pool.queue, false)
+	return report
+}
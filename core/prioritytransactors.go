@@ -8,30 +8,100 @@ import (
 	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
 	"github.com/electroneum/electroneum-sc/core/vm"
 	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/metrics"
 	"github.com/electroneum/electroneum-sc/params"
+	lru "github.com/hashicorp/golang-lru"
 )
 
+// priorityTransactorsCacheLimit is the number of decoded transactor sets cached.
+const priorityTransactorsCacheLimit = 64
+
+var (
+	priorityTransactorsCacheHitMeter  = metrics.NewRegisteredMeter("chain/prioritytransactors/cache/hit", nil)
+	priorityTransactorsCacheMissMeter = metrics.NewRegisteredMeter("chain/prioritytransactors/cache/miss", nil)
+)
+
+// priorityTransactorsCache holds the transactor sets decoded from the priority
+// contract, shared by the state processor, the miner and the transaction pool.
+// The sets are keyed by the contract storage and code, so an entry is never
+// stale: any change to the contract yields a different key.
+var priorityTransactorsCache, _ = lru.New(priorityTransactorsCacheLimit)
+
+// priorityTransactorsKey identifies the state of a priority contract.
+type priorityTransactorsKey struct {
+	address     common.Address
+	storageRoot common.Hash
+	codeHash    common.Hash
+}
+
+// storageRootReader is implemented by the state databases able to report the
+// storage root of an account, including its uncommitted changes.
+type storageRootReader interface {
+	GetStorageRoot(addr common.Address) common.Hash
+}
+
 // GetPriorityTransactors Gets the priority transactor list for the current state using the priority contract address for the block number passed
 func GetPriorityTransactors(evm *vm.EVM) common.PriorityTransactorMap {
-	var (
-		blockNumber = evm.Context.BlockNumber
-		config      = evm.ChainConfig()
-		address     = config.GetPriorityTransactorsContractAddress(blockNumber)
-		contract    = vm.AccountRef(address)
-		method      = "getTransactors"
-		result      = make(common.PriorityTransactorMap)
-	)
+	address := evm.ChainConfig().GetPriorityTransactorsContractAddress(evm.Context.BlockNumber)
 
 	// No contract configured => no priority transactors
 	if address == (common.Address{}) {
-		return result
+		return make(common.PriorityTransactorMap)
 	}
-
 	// Contract not deployed yet => no priority transactors (not an error)
-	byteCode := evm.StateDB.GetCode(address)
-	if len(byteCode) == 0 {
-		return result
+	codeHash := evm.StateDB.GetCodeHash(address)
+	if codeHash == (common.Hash{}) || codeHash == emptyCodeHash {
+		return make(common.PriorityTransactorMap)
 	}
+	reader, ok := evm.StateDB.(storageRootReader)
+	if !ok {
+		return readPriorityTransactors(evm, address)
+	}
+	key := priorityTransactorsKey{
+		address:     address,
+		storageRoot: reader.GetStorageRoot(address),
+		codeHash:    codeHash,
+	}
+	if cached, ok := priorityTransactorsCache.Get(key); ok {
+		priorityTransactorsCacheHitMeter.Mark(1)
+		return copyPriorityTransactors(cached.(common.PriorityTransactorMap))
+	}
+	priorityTransactorsCacheMissMeter.Mark(1)
+
+	result := readPriorityTransactors(evm, address)
+	priorityTransactorsCache.Add(key, copyPriorityTransactors(result))
+	return result
+}
+
+// RefreshPriorityTransactors reloads the priority transactors cached in the
+// state of the EVM after it applied the given message, if the message was sent
+// to the priority contract and may have changed them for the transactions
+// following it in the block.
+func RefreshPriorityTransactors(evm *vm.EVM, msg Message) {
+	if to := msg.To(); to != nil && *to == evm.ChainConfig().GetPriorityTransactorsContractAddress(evm.Context.BlockNumber) {
+		evm.StateDB.SetPriorityTransactors(GetPriorityTransactors(evm))
+	}
+}
+
+// copyPriorityTransactors returns a copy of the transactor map, keeping the
+// cached sets safe from modification by the callers.
+func copyPriorityTransactors(transactors common.PriorityTransactorMap) common.PriorityTransactorMap {
+	cpy := make(common.PriorityTransactorMap, len(transactors))
+	for pubkey, transactor := range transactors {
+		cpy[pubkey] = transactor
+	}
+	return cpy
+}
+
+// readPriorityTransactors calls the priority contract deployed at the given
+// address and decodes the transactors and their limits.
+func readPriorityTransactors(evm *vm.EVM, address common.Address) common.PriorityTransactorMap {
+	var (
+		blockNumber = evm.Context.BlockNumber
+		contract    = vm.AccountRef(address)
+		method      = "getTransactors"
+		result      = make(common.PriorityTransactorMap)
+	)
 
 	contractABI, err := abi.JSON(strings.NewReader(prioritytransactors.ETNPriorityTransactorsInterfaceMetaData.ABI))
 	if err != nil {
@@ -119,6 +189,32 @@ func GetPriorityTransactors(evm *vm.EVM) common.PriorityTransactorMap {
 		}
 	}
 
+	// The limits are optional: contracts predating them revert and leave the
+	// transactors with the locally configured limits only
+	input, err = contractABI.Pack("getTransactorLimits")
+	if err != nil {
+		return result
+	}
+	output, _, err = evm.StaticCall(contract, address, input, params.MaxGasLimit)
+	if err != nil {
+		log.Debug("PriorityTransactors: transactor limits unavailable",
+			"err", err, "address", address, "block", blockNumber)
+		return result
+	}
+	unpackResult, err = contractABI.Unpack("getTransactorLimits", output)
+	if err != nil || len(unpackResult) == 0 {
+		log.Warn("PriorityTransactors: ABI unpack of transactor limits failed; ignoring limits",
+			"err", err, "address", address, "block", blockNumber)
+		return result
+	}
+	limits, ok := safeConvertTransactorLimits(unpackResult[0])
+	if !ok {
+		log.Warn("PriorityTransactors: unexpected transactor limits type; ignoring limits",
+			"address", address, "block", blockNumber)
+		return result
+	}
+	prioritytransactors.SetLimits(result, limits)
+
 	return result
 }
 
@@ -139,3 +235,21 @@ func safeConvertTransactorsMeta(unpack0 any) (metas []prioritytransactors.ETNPri
 	}
 	return *ptr, true
 }
+
+func safeConvertTransactorLimits(unpack0 any) (limits []prioritytransactors.ETNPriorityTransactorsInterfaceTransactorLimits, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	ptr := abi.ConvertType(
+		unpack0,
+		new([]prioritytransactors.ETNPriorityTransactorsInterfaceTransactorLimits),
+	).(*[]prioritytransactors.ETNPriorityTransactorsInterfaceTransactorLimits)
+
+	if ptr == nil {
+		return nil, false
+	}
+	return *ptr, true
+}
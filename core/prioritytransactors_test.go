@@ -1,12 +1,68 @@
 package core
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
 )
 
+// Test that the transactors read from the priority contract, limits included,
+// are cached by contract storage and code, and read again once either changes.
+func TestGetPriorityTransactorsCache(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	pubkey := common.BytesToPublicKey(crypto.FromECDSAPub(&key.PublicKey))
+	transactors := common.PriorityTransactorMap{
+		pubkey: {
+			IsGasPriceWaiver: true,
+			EntityName:       "TestEntity",
+			Limits:           common.PriorityTransactorLimits{MaxPending: 4, MaxBlockGas: 100_000},
+		},
+	}
+	code, err := prioritytransactors.StubCode(transactors)
+	if err != nil {
+		t.Fatalf("failed to create priority contract code: %v", err)
+	}
+	config := *params.AllEthashProtocolChanges
+	config.PriorityTransactorsContractAddress = common.HexToAddress("0x00000000000000000000000000000000000000ee")
+	address := config.PriorityTransactorsContractAddress
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.SetCode(address, code)
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, statedb, &config, vm.Config{})
+
+	cacheKey := func() priorityTransactorsKey {
+		return priorityTransactorsKey{address, statedb.GetStorageRoot(address), statedb.GetCodeHash(address)}
+	}
+	have := GetPriorityTransactors(evm)
+	if len(have) != 1 || have[pubkey] != transactors[pubkey] {
+		t.Fatalf("transactors mismatch: have %v, want %v", have, transactors)
+	}
+	if !priorityTransactorsCache.Contains(cacheKey()) {
+		t.Fatal("transactors not cached")
+	}
+	// Modifying the returned set must not affect the cached one
+	delete(have, pubkey)
+	if have := GetPriorityTransactors(evm); have[pubkey] != transactors[pubkey] {
+		t.Fatalf("cached transactors modified: have %v", have)
+	}
+	// A storage change invalidates the cached set
+	statedb.SetState(address, common.Hash{0x01}, common.Hash{0x01})
+	if priorityTransactorsCache.Contains(cacheKey()) {
+		t.Fatal("transactors cached for unseen storage")
+	}
+	GetPriorityTransactors(evm)
+	if !priorityTransactorsCache.Contains(cacheKey()) {
+		t.Fatal("transactors not cached after storage change")
+	}
+}
+
 // Test that safeConvertTransactorsMeta returns ok=true and the correct slice
 // when given a valid []ETNPriorityTransactorsInterfaceTransactorMeta value.
 func TestSafeConvertTransactorsMeta_ValidInput(t *testing.T) {
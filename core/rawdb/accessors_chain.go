@@ -678,6 +678,43 @@ func DeleteReceipts(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
 	}
 }
 
+// HasBlockTraces verifies the existence of the call traces belonging to a block.
+// Frozen blocks may have an empty entry, if they were not traced before.
+func HasBlockTraces(db ethdb.Reader, hash common.Hash, number uint64) bool {
+	return len(ReadBlockTraces(db, hash, number)) > 0
+}
+
+// ReadBlockTraces retrieves the call traces of all the transactions in a block,
+// as stored by the trace indexer.
+func ReadBlockTraces(db ethdb.Reader, hash common.Hash, number uint64) []byte {
+	var data []byte
+	db.ReadAncients(func(reader ethdb.AncientReaderOp) error {
+		// Check if the data is in ancients
+		if isCanon(reader, number, hash) {
+			data, _ = reader.Ancient(freezerTracesTable, number)
+			return nil
+		}
+		// If not, try reading from leveldb
+		data, _ = db.Get(blockTracesKey(number, hash))
+		return nil
+	})
+	return data
+}
+
+// WriteBlockTraces stores the call traces of all the transactions in a block.
+func WriteBlockTraces(db ethdb.KeyValueWriter, hash common.Hash, number uint64, traces []byte) {
+	if err := db.Put(blockTracesKey(number, hash), traces); err != nil {
+		log.Crit("Failed to store block traces", "err", err)
+	}
+}
+
+// DeleteBlockTraces removes all the call traces associated with a block.
+func DeleteBlockTraces(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(blockTracesKey(number, hash)); err != nil {
+		log.Crit("Failed to delete block traces", "err", err)
+	}
+}
+
 // storedReceiptRLP is the storage encoding of a receipt.
 // Re-definition in core/types/receipt.go.
 type storedReceiptRLP struct {
@@ -844,6 +881,10 @@ func writeAncientBlock(op ethdb.AncientWriteOp, block *types.Block, header *type
 	if err := op.Append(freezerDifficultyTable, num, td); err != nil {
 		return fmt.Errorf("can't append block %d total difficulty: %v", num, err)
 	}
+	// Blocks imported straight into the ancients are never traced
+	if err := op.AppendRaw(freezerTracesTable, num, nil); err != nil {
+		return fmt.Errorf("can't append block %d traces: %v", num, err)
+	}
 	return nil
 }
 
@@ -853,6 +894,7 @@ func DeleteBlock(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
 	DeleteHeader(db, hash, number)
 	DeleteBody(db, hash, number)
 	DeleteTd(db, hash, number)
+	DeleteBlockTraces(db, hash, number)
 }
 
 // DeleteBlockWithoutNumber removes all block data associated with a hash, except
@@ -862,6 +904,7 @@ func DeleteBlockWithoutNumber(db ethdb.KeyValueWriter, hash common.Hash, number
 	deleteHeaderWithoutNumber(db, hash, number)
 	DeleteBody(db, hash, number)
 	DeleteTd(db, hash, number)
+	DeleteBlockTraces(db, hash, number)
 }
 
 const badBlockToKeep = 10
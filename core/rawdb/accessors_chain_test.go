@@ -432,6 +432,29 @@ func checkReceiptsRLP(have, want types.Receipts) error {
 	return nil
 }
 
+// Tests block call traces storage and retrieval operations.
+func TestBlockTracesStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+
+	hash, number := common.Hash{0x01}, uint64(1)
+	if HasBlockTraces(db, hash, number) {
+		t.Fatalf("non existent traces returned")
+	}
+	traces := []byte(`[{"result":{"type":"CALL"}}]`)
+	WriteBlockTraces(db, hash, number, traces)
+	if blob := ReadBlockTraces(db, hash, number); !bytes.Equal(blob, traces) {
+		t.Fatalf("traces mismatch: have %s, want %s", blob, traces)
+	}
+	if HasBlockTraces(db, common.Hash{0x02}, number) {
+		t.Fatalf("traces returned for another block")
+	}
+	// Traces are removed along with their block
+	DeleteBlock(db, hash, number)
+	if HasBlockTraces(db, hash, number) {
+		t.Fatalf("deleted traces returned")
+	}
+}
+
 func TestAncientStorage(t *testing.T) {
 	// Freezer style fast import the chain.
 	frdir := t.TempDir()
@@ -479,6 +502,9 @@ func TestAncientStorage(t *testing.T) {
 	if blob := ReadTdRLP(db, hash, number); len(blob) == 0 {
 		t.Fatalf("no td returned")
 	}
+	if HasBlockTraces(db, hash, number) {
+		t.Fatalf("traces returned for untraced ancient block")
+	}
 
 	// Use a fake hash for data retrieval, nothing should be returned.
 	fakeHash := common.BytesToHash([]byte{0x01, 0x02, 0x03})
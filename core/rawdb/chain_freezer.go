@@ -276,6 +276,8 @@ func (f *chainFreezer) freezeRange(nfdb *nofreezedb, number, limit uint64) (hash
 			if len(td) == 0 {
 				return fmt.Errorf("total difficulty missing, can't freeze block %d", number)
 			}
+			// Traces are optional, untraced blocks are frozen with an empty entry
+			traces, _ := nfdb.Get(blockTracesKey(number, hash))
 
 			// Write to the batch.
 			if err := op.AppendRaw(freezerHashTable, number, hash[:]); err != nil {
@@ -293,6 +295,9 @@ func (f *chainFreezer) freezeRange(nfdb *nofreezedb, number, limit uint64) (hash
 			if err := op.AppendRaw(freezerDifficultyTable, number, td); err != nil {
 				return fmt.Errorf("can't write td to Freezer: %v", err)
 			}
+			if err := op.AppendRaw(freezerTracesTable, number, traces); err != nil {
+				return fmt.Errorf("can't write traces to Freezer: %v", err)
+			}
 
 			hashes = append(hashes, hash)
 		}
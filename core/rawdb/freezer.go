@@ -115,7 +115,17 @@ func NewFreezer(datadir string, namespace string, readonly bool, maxTableSize ui
 	}
 
 	// Create the tables.
+	var added []string
 	for name, disableSnappy := range tables {
+		if !tableExists(datadir, name, disableSnappy) {
+			// Tables added since the last write access can't be created in
+			// readonly mode, leave them out until then.
+			if readonly {
+				log.Warn("Ancient table missing in readonly mode", "table", name)
+				continue
+			}
+			added = append(added, name)
+		}
 		table, err := newTable(datadir, name, readMeter, writeMeter, sizeGauge, maxTableSize, disableSnappy, readonly)
 		if err != nil {
 			for _, table := range freezer.tables {
@@ -132,8 +142,10 @@ func NewFreezer(datadir string, namespace string, readonly bool, maxTableSize ui
 		// validate also sets `freezer.frozen`.
 		err = freezer.validate()
 	} else {
-		// Truncate all tables to common length.
-		err = freezer.repair()
+		// Fill up the newly added tables, then truncate all tables to common length.
+		if err = freezer.fill(added); err == nil {
+			err = freezer.repair()
+		}
 	}
 	if err != nil {
 		for _, table := range freezer.tables {
@@ -377,6 +389,52 @@ func (f *Freezer) repair() error {
 	return nil
 }
 
+// fill appends empty items to the given tables, newly added to an already
+// populated freezer, up to the length of the other tables. Otherwise repair
+// would truncate all the frozen data down to the empty new tables.
+func (f *Freezer) fill(added []string) error {
+	if len(added) == 0 || len(added) == len(f.tables) {
+		return nil
+	}
+	isAdded := make(map[string]bool, len(added))
+	for _, name := range added {
+		isAdded[name] = true
+	}
+	head := uint64(math.MaxUint64)
+	for name, table := range f.tables {
+		if items := atomic.LoadUint64(&table.items); !isAdded[name] && items < head {
+			head = items
+		}
+	}
+	for _, name := range added {
+		table := f.tables[name]
+		if items := atomic.LoadUint64(&table.items); items >= head {
+			continue
+		}
+		log.Info("Filling up added ancient table", "table", name, "items", head)
+		batch := table.newBatch()
+		for item := atomic.LoadUint64(&table.items); item < head; item++ {
+			if err := batch.AppendRaw(item, nil); err != nil {
+				return err
+			}
+		}
+		if err := batch.commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tableExists returns whether the index file of the given freezer table exists.
+func tableExists(datadir string, name string, noCompression bool) bool {
+	idxName := fmt.Sprintf("%s.cidx", name)
+	if noCompression {
+		idxName = fmt.Sprintf("%s.ridx", name)
+	}
+	_, err := os.Stat(filepath.Join(datadir, idxName))
+	return err == nil
+}
+
 // convertLegacyFn takes a raw freezer entry in an older format and
 // returns it in the new format.
 type convertLegacyFn = func([]byte) ([]byte, error)
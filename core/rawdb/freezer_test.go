@@ -283,6 +283,65 @@ func TestFreezerReadonlyValidate(t *testing.T) {
 	}
 }
 
+// Tests that a table added to a populated freezer is filled up with empty
+// items instead of truncating the existing tables, and that it is left out
+// when opened in readonly mode before that.
+func TestFreezerAddTable(t *testing.T) {
+	dir := t.TempDir()
+	f, err := NewFreezer(dir, "", false, 2049, map[string]bool{"a": true, "b": false})
+	if err != nil {
+		t.Fatal("can't open freezer", err)
+	}
+	var item = make([]byte, 1024)
+	_, err = f.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+		for i := uint64(0); i < 5; i++ {
+			require.NoError(t, op.AppendRaw("a", i, item))
+			require.NoError(t, op.AppendRaw("b", i, item))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// Readonly access can't create the added tables
+	tables := map[string]bool{"a": true, "b": false, "c": true, "d": false}
+	f, err = NewFreezer(dir, "", true, 2049, tables)
+	if err != nil {
+		t.Fatal("can't open readonly freezer", err)
+	}
+	if _, err := f.Ancient("c", 0); err != errUnknownTable {
+		t.Fatalf("unexpected error reading table missing in readonly mode: %v", err)
+	}
+	require.NoError(t, f.Close())
+
+	// Write access fills the added tables up
+	f, err = NewFreezer(dir, "", false, 2049, tables)
+	if err != nil {
+		t.Fatal("can't reopen freezer", err)
+	}
+	defer f.Close()
+
+	checkAncientCount(t, f, "a", 5)
+	for _, kind := range []string{"c", "d"} {
+		checkAncientCount(t, f, kind, 5)
+		if blob, err := f.Ancient(kind, 4); err != nil || len(blob) != 0 {
+			t.Fatalf("unexpected filled up item in %s: %x, %v", kind, blob, err)
+		}
+	}
+	// The tables can be appended to together afterwards
+	_, err = f.ModifyAncients(func(op ethdb.AncientWriteOp) error {
+		for _, kind := range []string{"a", "b", "c", "d"} {
+			require.NoError(t, op.AppendRaw(kind, 5, item))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	checkAncientCount(t, f, "d", 6)
+	if blob, err := f.Ancient("d", 5); err != nil || !bytes.Equal(blob, item) {
+		t.Fatalf("unexpected item in d: %v", err)
+	}
+}
+
 func newFreezerForTesting(t *testing.T, tables map[string]bool) (*Freezer, string) {
 	t.Helper()
 
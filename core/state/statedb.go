@@ -369,6 +369,22 @@ func (s *StateDB) StorageTrie(addr common.Address) Trie {
 	return cpy.getTrie(s.db)
 }
 
+// GetStorageRoot returns the storage root of an account, including the storage
+// changes not yet hashed into it. It is the zero hash for non-existent accounts.
+func (s *StateDB) GetStorageRoot(addr common.Address) common.Hash {
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil {
+		return common.Hash{}
+	}
+	if len(stateObject.dirtyStorage) == 0 && len(stateObject.pendingStorage) == 0 {
+		return stateObject.data.Root
+	}
+	// Hash the changes on a copy detached from the snapshot and the prefetcher,
+	// the account itself is only updated when the state root is computed
+	detached := &StateDB{db: s.db, hasher: crypto.NewKeccakState()}
+	return stateObject.deepCopy(detached).updateTrie(s.db).Hash()
+}
+
 func (s *StateDB) HasSuicided(addr common.Address) bool {
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
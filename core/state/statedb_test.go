@@ -914,3 +914,30 @@ func TestStateDBAccessList(t *testing.T) {
 		t.Fatalf("expected empty, got %d", got)
 	}
 }
+
+// Tests that the storage root of an account includes its uncommitted storage
+// changes, without hashing them into the account.
+func TestGetStorageRoot(t *testing.T) {
+	state, _ := New(common.Hash{}, NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	addr := common.Address{0x01}
+
+	if root := state.GetStorageRoot(addr); root != (common.Hash{}) {
+		t.Fatalf("non-existent account root mismatch: have %x, want zero", root)
+	}
+	state.SetState(addr, common.Hash{0x01}, common.Hash{0x02})
+	root := state.GetStorageRoot(addr)
+	if root == emptyRoot {
+		t.Fatal("storage root misses the dirty storage")
+	}
+	if state.getStateObject(addr).data.Root != emptyRoot {
+		t.Fatal("account root updated before hashing the state")
+	}
+	state.Finalise(false)
+	if have := state.GetStorageRoot(addr); have != root {
+		t.Fatalf("pending storage root mismatch: have %x, want %x", have, root)
+	}
+	state.IntermediateRoot(false)
+	if have := state.getStateObject(addr).data.Root; have != root {
+		t.Fatalf("hashed storage root mismatch: have %x, want %x", have, root)
+	}
+}
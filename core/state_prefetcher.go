@@ -53,8 +53,8 @@ func (p *statePrefetcher) Prefetch(block *types.Block, statedb *state.StateDB, c
 		gaspool      = new(GasPool).AddGas(block.GasLimit())
 		blockContext = NewEVMBlockContext(header, p.bc, nil)
 		evm          = vm.NewEVM(blockContext, vm.TxContext{}, statedb, p.config, cfg)
-		signer       = types.MakeSigner(p.config, header.Number)
 	)
+	statedb.SetPriorityTransactors(GetPriorityTransactors(evm))
 	// Iterate over and process the individual transactions
 	byzantium := p.config.IsByzantium(block.Number())
 	for i, tx := range block.Transactions() {
@@ -63,7 +63,7 @@ func (p *statePrefetcher) Prefetch(block *types.Block, statedb *state.StateDB, c
 			return
 		}
 		// Convert the transaction into an executable message and pre-cache its sender
-		msg, err := tx.AsMessage(signer, header.BaseFee)
+		msg, err := TransactionToMessage(p.config, header, tx, statedb.GetPriorityTransactorByKey)
 		if err != nil {
 			return // Also invalid block, bail out
 		}
@@ -90,5 +90,8 @@ func precacheTransaction(msg types.Message, config *params.ChainConfig, gaspool
 	evm.Reset(NewEVMTxContext(msg), statedb)
 	// Add addresses to access list if applicable
 	_, err := ApplyMessage(evm, msg, gaspool)
+	if err == nil {
+		RefreshPriorityTransactors(evm, msg)
+	}
 	return err
 }
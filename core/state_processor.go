@@ -75,16 +75,18 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 	statedb.SetPriorityTransactors(GetPriorityTransactors(vmenv))
 	// Iterate over and process the individual transactions
 	for i, tx := range block.Transactions() {
-		msg, err := tx.AsMessage(types.MakeSigner(p.config, header.Number), header.BaseFee)
+		signer := types.MakeSigner(p.config, header.Number)
+		msg, err := tx.AsMessage(signer, header.BaseFee)
 		if err != nil {
 			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
 		// Validate priority transaction
 		if tx.Type() == types.PriorityTxType {
-			transactor, found := statedb.GetPriorityTransactorByKey(msg.PrioritySender())
+			pubkey, transactor, found, _ := ResolvePrioritySender(p.config, header.Number, signer, tx, statedb.GetPriorityTransactorByKey)
 			if !found {
 				return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), errBadPriorityKey)
 			}
+			msg = msg.WithPrioritySender(pubkey)
 			if !transactor.IsGasPriceWaiver {
 				if p.config.IsLondon(blockNumber) {
 					// Priority senders without gas price waiver must pay base fee (EIP-1559).
@@ -144,6 +146,7 @@ func applyTransaction(msg types.Message, config *params.ChainConfig, bc ChainCon
 	}
 	receipt.TxHash = tx.Hash()
 	receipt.GasUsed = result.UsedGas
+	receipt.Payer = msg.Sponsor()
 
 	// If the transaction created a contract, store the creation address in the receipt.
 	if msg.To() == nil {
@@ -159,9 +162,7 @@ func applyTransaction(msg types.Message, config *params.ChainConfig, bc ChainCon
 
 	// Update the priority transactor map for the next tx application in the block validation loop if this tx
 	// successfully updated the priority transactor list in the EVM/stateDB.
-	if msg.To() != nil && *msg.To() == config.GetPriorityTransactorsContractAddress(blockNumber) {
-		statedb.SetPriorityTransactors(GetPriorityTransactors(evm))
-	}
+	RefreshPriorityTransactors(evm, msg)
 	return receipt, err
 }
 
@@ -170,7 +171,8 @@ func applyTransaction(msg types.Message, config *params.ChainConfig, bc ChainCon
 // for the transaction, gas used and an error if the transaction failed,
 // indicating the block was invalid.
 func ApplyTransaction(config *params.ChainConfig, bc ChainContext, author *common.Address, gp *GasPool, statedb *state.StateDB, header *types.Header, tx *types.Transaction, usedGas *uint64, cfg vm.Config) (*types.Receipt, error) {
-	msg, err := tx.AsMessage(types.MakeSigner(config, header.Number), header.BaseFee)
+	signer := types.MakeSigner(config, header.Number)
+	msg, err := tx.AsMessage(signer, header.BaseFee)
 	if err != nil {
 		return nil, err
 	}
@@ -180,10 +182,11 @@ func ApplyTransaction(config *params.ChainConfig, bc ChainContext, author *commo
 
 	// Validate priority transaction
 	if tx.Type() == types.PriorityTxType {
-		transactor, found := statedb.GetPriorityTransactorByKey(msg.PrioritySender())
+		pubkey, transactor, found, _ := ResolvePrioritySender(config, header.Number, signer, tx, statedb.GetPriorityTransactorByKey)
 		if !found {
 			return nil, fmt.Errorf("could not apply tx [%v]: %w", tx.Hash().Hex(), errBadPriorityKey)
 		}
+		msg = msg.WithPrioritySender(pubkey)
 		if !transactor.IsGasPriceWaiver {
 			if config.IsLondon(header.Number) {
 				// Priority senders without gas price waiver must pay base fee (EIP-1559).
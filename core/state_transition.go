@@ -74,6 +74,7 @@ type Message interface {
 	GasFeeCap() *big.Int
 	GasTipCap() *big.Int
 	PrioritySender() common.PublicKey
+	Sponsor() *common.Address
 	Gas() uint64
 	Value() *big.Int
 
@@ -193,6 +194,15 @@ func (st *StateTransition) to() common.Address {
 	return *st.msg.To()
 }
 
+// payer returns the account paying for the gas of the message: the sponsor of
+// a sponsored transaction, or the sender otherwise.
+func (st *StateTransition) payer() common.Address {
+	if sponsor := st.msg.Sponsor(); sponsor != nil {
+		return *sponsor
+	}
+	return st.msg.From()
+}
+
 func (st *StateTransition) buyGas() error {
 	mgval := new(big.Int).SetUint64(st.msg.Gas())
 	mgval = mgval.Mul(mgval, st.gasPrice)
@@ -200,10 +210,15 @@ func (st *StateTransition) buyGas() error {
 	if st.gasFeeCap != nil {
 		balanceCheck = new(big.Int).SetUint64(st.msg.Gas())
 		balanceCheck = balanceCheck.Mul(balanceCheck, st.gasFeeCap)
-		balanceCheck.Add(balanceCheck, st.value)
+		// The value of a sponsored transaction is paid by the sender and
+		// checked against its balance before the transfer.
+		if st.msg.Sponsor() == nil {
+			balanceCheck.Add(balanceCheck, st.value)
+		}
 	}
-	if have, want := st.state.GetBalance(st.msg.From()), balanceCheck; have.Cmp(want) < 0 {
-		return fmt.Errorf("%w: address %v have %v want %v", ErrInsufficientFunds, st.msg.From().Hex(), have, want)
+	payer := st.payer()
+	if have, want := st.state.GetBalance(payer), balanceCheck; have.Cmp(want) < 0 {
+		return fmt.Errorf("%w: address %v have %v want %v", ErrInsufficientFunds, payer.Hex(), have, want)
 	}
 	if err := st.gp.SubGas(st.msg.Gas()); err != nil {
 		return err
@@ -211,7 +226,7 @@ func (st *StateTransition) buyGas() error {
 	st.gas += st.msg.Gas()
 
 	st.initialGas = st.msg.Gas()
-	st.state.SubBalance(st.msg.From(), mgval)
+	st.state.SubBalance(payer, mgval)
 	return nil
 }
 
@@ -236,6 +251,10 @@ func (st *StateTransition) preCheck() error {
 				st.msg.From().Hex(), codeHash)
 		}
 	}
+	// Make sure sponsored transactions are only accepted after their fork
+	if st.msg.Sponsor() != nil && !st.evm.ChainConfig().IsSponsoredTx(st.evm.Context.BlockNumber) {
+		return fmt.Errorf("%w: sponsored transaction before fork, address %v", ErrTxTypeNotSupported, st.msg.From().Hex())
+	}
 	// Make sure that transaction gasFeeCap is greater than the baseFee (post london)
 	if st.evm.ChainConfig().IsLondon(st.evm.Context.BlockNumber) {
 		// Skip the checks if gas fields are zero and baseFee was explicitly disabled (eth_call)
@@ -290,8 +309,10 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	// 6. caller has enough balance to cover asset transfer for **topmost** call
 	// 7. Priority txes are signed with a pubkey in the priority key map & have the
 	//    correct gas pricing contingent on the key's gas price waiver status.
+	// 8. Sponsored txes are only applied after their fork, and the sponsor rather
+	//    than the caller pays for the gas.
 
-	// Check clauses 1-3 and 7, buy gas if everything is correct
+	// Check clauses 1-3, 7 and 8, buy gas if everything is correct
 	if err := st.preCheck(); err != nil {
 		return nil, err
 	}
@@ -374,7 +395,7 @@ func (st *StateTransition) refundGas(refundQuotient uint64) {
 
 	// Return ETH for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(st.gas), st.gasPrice)
-	st.state.AddBalance(st.msg.From(), remaining)
+	st.state.AddBalance(st.payer(), remaining)
 
 	// Also return remaining gas to the block gas counter so it is
 	// available for the next transaction.
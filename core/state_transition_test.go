@@ -442,3 +442,68 @@ func searchString(s, substr string) bool {
 	}
 	return false
 }
+
+// TestSponsoredTxFeePaidBySponsor verifies that the gas of a sponsored
+// transaction is bought from and refunded to its sponsor, while the value is
+// still paid by the sender, and that the transaction is rejected before the
+// sponsored transaction fork.
+func TestSponsoredTxFeePaidBySponsor(t *testing.T) {
+	var (
+		key, _        = crypto.GenerateKey()
+		sponsorKey, _ = crypto.GenerateKey()
+		from          = crypto.PubkeyToAddress(key.PublicKey)
+		sponsor       = crypto.PubkeyToAddress(sponsorKey.PublicKey)
+		to            = common.HexToAddress("0xdead")
+		baseFee       = big.NewInt(1_000_000_000)
+		tipCap        = big.NewInt(1)
+	)
+	tx, err := types.SignNewSponsoredTx(key, sponsorKey, types.NewLondonSigner(big.NewInt(1)), &types.SponsoredTx{
+		ChainID:   big.NewInt(1),
+		GasTipCap: tipCap,
+		GasFeeCap: new(big.Int).Mul(baseFee, big.NewInt(2)),
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign sponsored tx: %v", err)
+	}
+	apply := func(sponsoredTxBlock *big.Int) (*state.StateDB, error) {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		statedb.AddBalance(from, big.NewInt(100))
+		statedb.AddBalance(sponsor, big.NewInt(params.Ether))
+
+		config := &params.ChainConfig{
+			ChainID:          big.NewInt(1),
+			LondonBlock:      big.NewInt(0),
+			SponsoredTxBlock: sponsoredTxBlock,
+		}
+		msg, err := tx.AsMessage(types.MakeSigner(config, big.NewInt(1)), baseFee)
+		if err != nil {
+			t.Fatalf("failed to convert sponsored tx: %v", err)
+		}
+		blockCtx := vm.BlockContext{
+			CanTransfer: CanTransfer,
+			Transfer:    Transfer,
+			BlockNumber: big.NewInt(1),
+			BaseFee:     baseFee,
+		}
+		evm := vm.NewEVM(blockCtx, NewEVMTxContext(msg), statedb, config, vm.Config{})
+		_, err = ApplyMessage(evm, msg, new(GasPool).AddGas(tx.Gas()))
+		return statedb, err
+	}
+	statedb, err := apply(big.NewInt(0))
+	if err != nil {
+		t.Fatalf("failed to apply sponsored tx: %v", err)
+	}
+	if have := statedb.GetBalance(from); have.Sign() != 0 {
+		t.Errorf("sender balance mismatch: have %v, want 0", have)
+	}
+	fee := new(big.Int).Mul(big.NewInt(int64(params.TxGas)), new(big.Int).Add(baseFee, tipCap))
+	if have, want := statedb.GetBalance(sponsor), new(big.Int).Sub(big.NewInt(params.Ether), fee); have.Cmp(want) != 0 {
+		t.Errorf("sponsor balance mismatch: have %v, want %v", have, want)
+	}
+	if _, err := apply(nil); !errors.Is(err, ErrTxTypeNotSupported) {
+		t.Errorf("expected %v before the fork, got %v", ErrTxTypeNotSupported, err)
+	}
+}
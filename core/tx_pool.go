@@ -65,6 +65,13 @@ var (
 	// ErrInvalidPrioritySender is returned if the priority transaction contains an invalid priority signature.
 	ErrInvalidPrioritySender = errors.New("invalid priority sender")
 
+	// ErrInvalidSponsor is returned if the sponsored transaction contains an invalid sponsor signature.
+	ErrInvalidSponsor = errors.New("invalid sponsor")
+
+	// ErrInsufficientSponsorFunds is returned if the sponsor of a sponsored transaction
+	// can't pay for the gas of it and of its other sponsored transactions in the pool.
+	ErrInsufficientSponsorFunds = errors.New("insufficient sponsor funds for gas * price")
+
 	// ErrUnderpriced is returned if a transaction's gas price is below the minimum
 	// configured for the transaction pool.
 	ErrUnderpriced = errors.New("transaction underpriced")
@@ -77,6 +84,10 @@ var (
 	// another remote priority transaction.
 	ErrPriorityTxPoolOverflow = errors.New("priority tx pool is full")
 
+	// ErrPriorityQuotaExceeded is returned if a gas price waived transaction would
+	// take its priority transactor over its pending or gas limits.
+	ErrPriorityQuotaExceeded = errors.New("priority transactor quota exceeded")
+
 	// ErrReplaceUnderpriced is returned if a transaction is attempted to be replaced
 	// with a different one without the required price bump.
 	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
@@ -159,6 +170,7 @@ const (
 type blockChain interface {
 	CurrentBlock() *types.Block
 	GetBlock(hash common.Hash, number uint64) *types.Block
+	GetReceiptsByHash(hash common.Hash) types.Receipts
 	StateAt(root common.Hash) (*state.StateDB, error)
 	SubscribeChainHeadEvent(ch chan<- ChainHeadEvent) event.Subscription
 	GetPriorityTransactorsForState(header *types.Header, state *state.StateDB) common.PriorityTransactorMap
@@ -171,6 +183,8 @@ type TxPoolConfig struct {
 	Journal   string           // Journal of local transactions to survive node restarts
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
 
+	PriorityJournal string // Journal of remote priority transactions to survive node restarts (disabled if empty)
+
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
 
@@ -181,6 +195,8 @@ type TxPoolConfig struct {
 	GlobalQueue   uint64 // Maximum number of non-executable transaction slots for all accounts
 	PriorityQueue uint64 // Maximum number of non-executable transaction slots for all accounts
 
+	PriorityQuota PriorityQuotaConfig // Limits on the gas price waived transactions of each priority transactor
+
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
 }
 
@@ -200,6 +216,8 @@ var DefaultTxPoolConfig = TxPoolConfig{
 	GlobalQueue:   1024,
 	PriorityQueue: 1024,
 
+	PriorityQuota: DefaultPriorityQuotaConfig,
+
 	Lifetime: 3 * time.Hour,
 }
 
@@ -271,6 +289,9 @@ type TxPool struct {
 	eip2718    bool // Fork indicator whether we are using EIP-2718 type transactions.
 	eip1559    bool // Fork indicator whether we are using EIP-1559 type transactions.
 	futureFork bool // Fork indicator whether we are using the future fork rules.
+	sponsored  bool // Fork indicator whether we are using sponsored transactions.
+
+	pendingBlock *big.Int // Number of the next block, resolving the priority signature scheme
 
 	currentState  *state.StateDB // Current state in the blockchain head
 	pendingNonces *txNoncer      // Pending state tracking virtual nonces
@@ -279,6 +300,8 @@ type TxPool struct {
 	locals  *accountSet // Set of local transaction to exempt from eviction rules
 	journal *txJournal  // Journal of local transaction to back up to disk
 
+	priorityJournal *txJournal // Journal of non-local priority transactions to back up to disk
+
 	pending map[common.Address]*txList   // All currently processable transactions
 	queue   map[common.Address]*txList   // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
@@ -298,6 +321,8 @@ type TxPool struct {
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
 	currentPriorityTransactors common.PriorityTransactorMap
+	priorityGas                *PriorityGasTracker         // Waived gas of recent blocks per priority transactor
+	priorityWindowGas          map[common.PublicKey]uint64 // Waived gas within the window preceding the next block
 }
 
 type txpoolResetRequest struct {
@@ -327,12 +352,17 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		reorgShutdownCh: make(chan struct{}),
 		initDoneCh:      make(chan struct{}),
 		gasPrice:        new(big.Int).SetUint64(config.PriceLimit),
+		priorityGas:     NewPriorityGasTracker(chainconfig),
 	}
 	pool.locals = newAccountSet(pool.signer)
 	for _, addr := range config.Locals {
 		log.Info("Setting new local account", "address", addr)
 		pool.locals.add(addr)
 	}
+	pool.all.waiverOf = func(tx *types.Transaction) (common.PublicKey, bool) {
+		return PriorityWaiver(pool.chainconfig, pool.pendingBlock, pool.prioritySigner(), tx, pool.currentPriorityTransactor)
+	}
+	pool.all.sponsorOf = pool.txSponsor
 	pool.priced = newTxPricedList(pool.all)
 	pool.reset(nil, chain.CurrentBlock().Header())
 
@@ -351,6 +381,18 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 			log.Warn("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	// If priority journaling is enabled, load from disk, re-validating every
+	// transaction against the current priority transactor set
+	if config.PriorityJournal != "" {
+		pool.priorityJournal = newTxJournal(config.PriorityJournal)
+
+		if err := pool.priorityJournal.load(pool.addJournaledPriorityTxs); err != nil {
+			log.Warn("Failed to load priority transaction journal", "err", err)
+		}
+		if err := pool.priorityJournal.rotate(pool.priority()); err != nil {
+			log.Warn("Failed to rotate priority transaction journal", "err", err)
+		}
+	}
 
 	// Subscribe events from blockchain and start the main event loop.
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
@@ -435,6 +477,13 @@ func (pool *TxPool) loop() {
 				}
 				pool.mu.Unlock()
 			}
+			if pool.priorityJournal != nil {
+				pool.mu.Lock()
+				if err := pool.priorityJournal.rotate(pool.priority()); err != nil {
+					log.Warn("Failed to rotate priority tx journal", "err", err)
+				}
+				pool.mu.Unlock()
+			}
 		}
 	}
 }
@@ -451,6 +500,9 @@ func (pool *TxPool) Stop() {
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.priorityJournal != nil {
+		pool.priorityJournal.close()
+	}
 	log.Info("Transaction pool stopped")
 }
 
@@ -651,6 +703,27 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 	return txs
 }
 
+// priority retrieves all currently known priority transactions that are not
+// already covered by the local journal, grouped by origin account and sorted by
+// nonce. The returned transaction set is a copy and can be freely modified by
+// calling code.
+func (pool *TxPool) priority() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions)
+	for _, lists := range []map[common.Address]*txList{pool.pending, pool.queue} {
+		for addr, list := range lists {
+			if pool.journal != nil && pool.locals.contains(addr) {
+				continue
+			}
+			for _, tx := range list.Flatten() {
+				if IsPriorityTransaction(tx) {
+					txs[addr] = append(txs[addr], tx)
+				}
+			}
+		}
+	}
+	return txs
+}
+
 // prioritySigner returns the signer to use when recovering the priority public
 // key of a priority transaction. Once the future fork is active it returns the
 // sender-bound futureForkSigner; otherwise it returns the pool's default signer.
@@ -663,6 +736,19 @@ func (pool *TxPool) prioritySigner() types.Signer {
 	return pool.signer
 }
 
+// prioritySender recovers the priority public key of a priority transaction and
+// looks up its transactor in the current set, accepting either priority
+// signature scheme within the grace window around the future fork.
+func (pool *TxPool) prioritySender(tx *types.Transaction) (common.PublicKey, common.PriorityTransactor, bool, error) {
+	return ResolvePrioritySender(pool.chainconfig, pool.pendingBlock, pool.prioritySigner(), tx, pool.currentPriorityTransactor)
+}
+
+// currentPriorityTransactor looks up a priority transactor in the current set.
+func (pool *TxPool) currentPriorityTransactor(pubkey common.PublicKey) (common.PriorityTransactor, bool) {
+	transactor, ok := pool.currentPriorityTransactors[pubkey]
+	return transactor, ok
+}
+
 // validateTx checks whether a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
@@ -674,6 +760,10 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	if !pool.eip1559 && (tx.Type() == types.DynamicFeeTxType || IsPriorityTransaction(tx)) {
 		return ErrTxTypeNotSupported
 	}
+	// Reject sponsored transactions until their fork activates.
+	if !pool.sponsored && tx.Type() == types.SponsoredTxType {
+		return ErrTxTypeNotSupported
+	}
 	// Reject transactions over defined size to prevent DOS attacks
 	if uint64(tx.Size()) > txMaxSize {
 		return ErrOversizedData
@@ -703,25 +793,77 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	if err != nil {
 		return ErrInvalidSender
 	}
-	isGasWaiver := false
+	var transactor *common.PriorityTransactor
 	if tx.Type() == types.PriorityTxType {
-		// Make sure the priority signature checks out.
-		// Use the future fork signer when the fork is active so that priority
-		// signatures are verified against the sender-bound hash.
-		priorityPubkey, err := types.PrioritySender(pool.prioritySigner(), tx)
+		priorityPubkey, allowed, err := pool.priorityTransactor(tx)
 		if err != nil {
-			return errBadPrioritySignature
-		}
-		// Make sure the priority public key is an allowed one
-		transactor, exists := pool.currentPriorityTransactors[priorityPubkey]
-		if !exists {
-			return errBadPriorityKey
-		}
-		isGasWaiver = transactor.IsGasPriceWaiver
-		// Mirror the execution-layer rule (validatePriorityGasFields): a waiver
-		// sender must submit zero fee fields. Without this the pool accepts a
-		// waiver tx with non-zero fees that execution always rejects, leaving it
-		// stuck in the pool forever.
+			return err
+		}
+		transactor = &allowed
+		// Mirror the execution-layer rule (validatePriorityGasFields) before
+		// anything else, so that waiver transactions with non-zero fees are
+		// refused rather than stuck in the pool forever.
+		if err := pool.validateFees(tx, local, transactor); err != nil {
+			return err
+		}
+		// Keep a single waiver transactor from crowding out everyone else
+		if transactor.IsGasPriceWaiver {
+			if err := pool.validatePriorityQuota(tx, from, priorityPubkey, *transactor); err != nil {
+				return err
+			}
+		}
+	} else if err := pool.validateFees(tx, local, nil); err != nil {
+		return err
+	}
+	// Ensure the transaction adheres to nonce ordering
+	if pool.currentState.GetNonce(from) > tx.Nonce() {
+		return ErrNonceTooLow
+	}
+	// Transactor should have enough funds to cover the costs
+	// cost == V + GP * GL, or V alone for sponsored transactions
+	if pool.currentState.GetBalance(from).Cmp(tx.Cost()) < 0 {
+		return ErrInsufficientFunds
+	}
+	// Sponsor should have enough funds to cover the gas of all its transactions
+	if tx.Type() == types.SponsoredTxType {
+		if err := pool.validateSponsor(tx, from); err != nil {
+			return err
+		}
+	}
+	// Ensure the transaction has more gas than the basic tx fee.
+	intrGas, err := IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, pool.istanbul)
+	if err != nil {
+		return err
+	}
+	if tx.Gas() < intrGas {
+		return ErrIntrinsicGas
+	}
+	return nil
+}
+
+// priorityTransactor recovers the priority public key of a priority transaction
+// and returns the allowed transactor it belongs to.
+func (pool *TxPool) priorityTransactor(tx *types.Transaction) (common.PublicKey, common.PriorityTransactor, error) {
+	// Make sure the priority signature checks out.
+	// Use the future fork signer when the fork is active so that priority
+	// signatures are verified against the sender-bound hash.
+	priorityPubkey, transactor, exists, err := pool.prioritySender(tx)
+	if err != nil {
+		return common.PublicKey{}, common.PriorityTransactor{}, errBadPrioritySignature
+	}
+	// Make sure the priority public key is an allowed one
+	if !exists {
+		return priorityPubkey, common.PriorityTransactor{}, errBadPriorityKey
+	}
+	return priorityPubkey, transactor, nil
+}
+
+// validateFees checks the fee fields of a transaction against the gas price
+// waiver status of its priority transactor, if any, and the local price limits.
+func (pool *TxPool) validateFees(tx *types.Transaction, local bool, transactor *common.PriorityTransactor) error {
+	isGasWaiver := transactor != nil && transactor.IsGasPriceWaiver
+	if transactor != nil {
+		// A waiver sender must submit zero fee fields, as execution requires
 		if isGasWaiver && !tx.HasZeroFee() {
 			return fmt.Errorf("%w: waiver priority tx must have zero fee fields", errNoGasPriceWaiver)
 		}
@@ -746,26 +888,87 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	if !local && !isGasWaiver && tx.GasTipCapIntCmp(pool.gasPrice) < 0 {
 		return ErrUnderpriced
 	}
-	// Ensure the transaction adheres to nonce ordering
-	if pool.currentState.GetNonce(from) > tx.Nonce() {
-		return ErrNonceTooLow
+	return nil
+}
+
+// validatePriorityQuota checks whether a gas price waived transaction stays
+// within the limits of its priority transactor.
+func (pool *TxPool) validatePriorityQuota(tx *types.Transaction, from common.Address, pubkey common.PublicKey, transactor common.PriorityTransactor) error {
+	limits := pool.config.PriorityQuota.Limits(transactor)
+	if limits.MaxBlockGas != 0 && tx.Gas() > limits.MaxBlockGas {
+		return fmt.Errorf("%w: gas %d above block limit %d", ErrPriorityQuotaExceeded, tx.Gas(), limits.MaxBlockGas)
 	}
-	// Transactor should have enough funds to cover the costs
-	// cost == V + GP * GL
-	if pool.currentState.GetBalance(from).Cmp(tx.Cost()) < 0 {
-		return ErrInsufficientFunds
+	if limits.MaxWindowGas != 0 && pool.priorityWindowGas[pubkey]+tx.Gas() > limits.MaxWindowGas {
+		return fmt.Errorf("%w: gas %d above window limit %d, used %d", ErrPriorityQuotaExceeded, tx.Gas(), limits.MaxWindowGas, pool.priorityWindowGas[pubkey])
 	}
-	// Ensure the transaction has more gas than the basic tx fee.
-	intrGas, err := IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, pool.istanbul)
+	if limits.MaxPending != 0 {
+		// Replacements don't add to the pending transactions of the transactor
+		if list := pool.pending[from]; list != nil && list.Overlaps(tx) {
+			return nil
+		}
+		if list := pool.queue[from]; list != nil && list.Overlaps(tx) {
+			return nil
+		}
+		if pending := pool.all.WaiverCount(pubkey); pending >= limits.MaxPending {
+			return fmt.Errorf("%w: %d pending transactions, limit %d", ErrPriorityQuotaExceeded, pending, limits.MaxPending)
+		}
+	}
+	return nil
+}
+
+// validateSponsor checks whether the sponsor of a sponsored transaction can pay
+// for its gas on top of the gas of its other sponsored transactions in the pool.
+func (pool *TxPool) validateSponsor(tx *types.Transaction, from common.Address) error {
+	sponsor, err := types.Sponsor(pool.signer, tx)
 	if err != nil {
-		return err
+		return ErrInvalidSponsor
 	}
-	if tx.Gas() < intrGas {
-		return ErrIntrinsicGas
+	cost := new(big.Int).Add(tx.SponsorCost(), pool.all.SponsorCost(sponsor))
+
+	// Replacements don't add to the gas paid by the sponsor
+	for _, list := range []*txList{pool.pending[from], pool.queue[from]} {
+		if list == nil {
+			continue
+		}
+		if old := list.txs.Get(tx.Nonce()); old != nil {
+			if s, ok := pool.txSponsor(old); ok && s == sponsor {
+				cost.Sub(cost, old.SponsorCost())
+			}
+		}
+	}
+
+	if pool.currentState.GetBalance(sponsor).Cmp(cost) < 0 {
+		return ErrInsufficientSponsorFunds
 	}
 	return nil
 }
 
+// txSponsor returns the sponsor of a sponsored transaction, and whether the
+// transaction is one.
+func (pool *TxPool) txSponsor(tx *types.Transaction) (common.Address, bool) {
+	if tx.Type() != types.SponsoredTxType {
+		return common.Address{}, false
+	}
+	sponsor, err := types.Sponsor(pool.signer, tx)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return sponsor, true
+}
+
+// sponsorUnpayable reports whether the sponsor of a sponsored transaction can no
+// longer pay for its gas.
+func (pool *TxPool) sponsorUnpayable(tx *types.Transaction) bool {
+	if tx.Type() != types.SponsoredTxType {
+		return false
+	}
+	sponsor, err := types.Sponsor(pool.signer, tx)
+	if err != nil {
+		return true
+	}
+	return pool.currentState.GetBalance(sponsor).Cmp(tx.SponsorCost()) < 0
+}
+
 // add validates a transaction and inserts it into the non-executable queue for later
 // pending promotion and execution. If the transaction is a replacement for an already
 // pending or queued one, it overwrites the previous transaction if its price is higher.
@@ -937,14 +1140,22 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction, local boo
 }
 
 // journalTx adds the specified transaction to the local disk journal if it is
-// deemed to have been sent from a local account.
+// deemed to have been sent from a local account, or to the priority journal if
+// it is a priority transaction of any other account.
 func (pool *TxPool) journalTx(from common.Address, tx *types.Transaction) {
-	// Only journal if it's enabled and the transaction is local
-	if pool.journal == nil || !pool.locals.contains(from) {
+	// Local transactions go into the local journal, if it's enabled
+	if pool.journal != nil && pool.locals.contains(from) {
+		if err := pool.journal.insert(tx); err != nil {
+			log.Warn("Failed to journal local transaction", "err", err)
+		}
+		return
+	}
+	// Only journal the rest if priority journaling is enabled
+	if pool.priorityJournal == nil || !IsPriorityTransaction(tx) {
 		return
 	}
-	if err := pool.journal.insert(tx); err != nil {
-		log.Warn("Failed to journal local transaction", "err", err)
+	if err := pool.priorityJournal.insert(tx); err != nil {
+		log.Warn("Failed to journal priority transaction", "err", err)
 	}
 }
 
@@ -997,6 +1208,37 @@ func (pool *TxPool) AddLocals(txs []*types.Transaction) []error {
 	return pool.addTxs(txs, !pool.config.NoLocals, true)
 }
 
+// addJournaledPriorityTxs enqueues a batch of journaled priority transactions
+// into the pool. The priority key of every transaction is first checked against
+// the current priority transactor set, dropping those whose key was revoked or
+// has expired since the transaction was journaled.
+func (pool *TxPool) addJournaledPriorityTxs(txs []*types.Transaction) []error {
+	var (
+		errs  = make([]error, len(txs))
+		valid = make([]*types.Transaction, 0, len(txs))
+		index = make([]int, 0, len(txs))
+	)
+	pool.mu.RLock()
+	for i, tx := range txs {
+		if !IsPriorityTransaction(tx) {
+			errs[i] = types.ErrTxTypeNotSupported
+			continue
+		}
+		if _, _, err := pool.priorityTransactor(tx); err != nil {
+			errs[i] = err
+			continue
+		}
+		valid = append(valid, tx)
+		index = append(index, i)
+	}
+	pool.mu.RUnlock()
+
+	for i, err := range pool.addTxs(valid, false, true) {
+		errs[index[i]] = err
+	}
+	return errs
+}
+
 // AddLocal enqueues a single local transaction into the pool if it is valid. This is
 // a convenience wrapper aroundd AddLocals.
 func (pool *TxPool) AddLocal(tx *types.Transaction) error {
@@ -1457,6 +1699,7 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.currentMaxGas = newHead.GasLimit
 
 	pool.currentPriorityTransactors = pool.chain.GetPriorityTransactorsForState(newHead, pool.currentState)
+	pool.priorityWindowGas = pool.priorityGas.WindowGas(pool.chain, newHead, pool.config.PriorityQuota.Window)
 
 	// Inject any transactions discarded due to reorgs
 	log.Debug("Reinjecting stale transactions", "count", len(reinject))
@@ -1469,6 +1712,8 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.eip2718 = pool.chainconfig.IsBerlin(next)
 	pool.eip1559 = pool.chainconfig.IsLondon(next)
 	pool.futureFork = pool.chainconfig.IsFutureFork(next)
+	pool.sponsored = pool.chainconfig.IsSponsoredTx(next)
+	pool.pendingBlock = next
 }
 
 // promoteExecutables moves transactions that have become processable from the
@@ -1488,13 +1733,19 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		// kick priority tx that have a key that's expired
 		for _, tx := range list.Flatten() {
 			if tx.Type() == types.PriorityTxType && !pool.locals.containsTx(tx) {
-				priorityPubkey, _ := types.PrioritySender(pool.prioritySigner(), tx) // no need to deal with error because this has already been validated once before
-				if _, ok := pool.currentPriorityTransactors[priorityPubkey]; !ok {
+				if _, _, ok, _ := pool.prioritySender(tx); !ok { // no need to deal with error because this has already been validated once before
 					pool.all.Remove(tx.Hash())
 				}
 			}
 		}
 
+		// Drop sponsored transactions whose sponsor can no longer pay for them
+		for _, tx := range list.Flatten() {
+			if pool.sponsorUnpayable(tx) {
+				pool.removeTx(tx.Hash(), true)
+			}
+		}
+
 		// Drop all transactions that are deemed too old (low nonce)
 		forwards := list.Forward(pool.currentState.GetNonce(addr))
 		for _, tx := range forwards {
@@ -1839,13 +2090,19 @@ func (pool *TxPool) demoteUnexecutables() {
 		// populating the queue unnecessarily and waste an account slot that could be used for another priority sender
 		for _, tx := range list.Flatten() {
 			if tx.Type() == types.PriorityTxType && !pool.locals.containsTx(tx) {
-				priorityPubkey, _ := types.PrioritySender(pool.prioritySigner(), tx) // no need to deal with error because this has already been validated once before
-				if _, ok := pool.currentPriorityTransactors[priorityPubkey]; !ok {
+				if _, _, ok, _ := pool.prioritySender(tx); !ok { // no need to deal with error because this has already been validated once before
 					pool.all.Remove(tx.Hash())
 				}
 			}
 		}
 
+		// Drop sponsored transactions whose sponsor can no longer pay for them
+		for _, tx := range list.Flatten() {
+			if pool.sponsorUnpayable(tx) {
+				pool.removeTx(tx.Hash(), true)
+			}
+		}
+
 		// Drop all transactions that are deemed too old (low nonce)
 		olds := list.Forward(nonce)
 		for _, tx := range olds {
@@ -2000,6 +2257,14 @@ type txLookup struct {
 	remotes         map[common.Hash]*types.Transaction
 	localsPriority  map[common.Hash]*types.Transaction
 	remotesPriority map[common.Hash]*types.Transaction
+
+	waiverOf     func(*types.Transaction) (common.PublicKey, bool) // Resolves the priority transactor of gas price waived transactions
+	waivers      map[common.Hash]common.PublicKey                  // Priority transactor of every gas price waived transaction
+	waiverCounts map[common.PublicKey]uint64                       // Number of gas price waived transactions per priority transactor
+
+	sponsorOf   func(*types.Transaction) (common.Address, bool) // Resolves the sponsor of sponsored transactions
+	sponsors    map[common.Hash]common.Address                  // Sponsor of every sponsored transaction
+	sponsorCost map[common.Address]*big.Int                     // Gas committed by every sponsor across its transactions
 }
 
 // newTxLookup returns a new txLookup structure.
@@ -2009,6 +2274,10 @@ func newTxLookup() *txLookup {
 		remotes:         make(map[common.Hash]*types.Transaction),
 		localsPriority:  make(map[common.Hash]*types.Transaction),
 		remotesPriority: make(map[common.Hash]*types.Transaction),
+		waivers:         make(map[common.Hash]common.PublicKey),
+		waiverCounts:    make(map[common.PublicKey]uint64),
+		sponsors:        make(map[common.Hash]common.Address),
+		sponsorCost:     make(map[common.Address]*big.Int),
 	}
 }
 
@@ -2143,6 +2412,20 @@ func (t *txLookup) PrioritySlots() int {
 
 // Add adds a transaction to the lookup.
 func (t *txLookup) Add(tx *types.Transaction, local bool) {
+	var (
+		waiver   common.PublicKey
+		isWaiver bool
+	)
+	if t.waiverOf != nil {
+		waiver, isWaiver = t.waiverOf(tx)
+	}
+	var (
+		sponsor     common.Address
+		isSponsored bool
+	)
+	if t.sponsorOf != nil {
+		sponsor, isSponsored = t.sponsorOf(tx)
+	}
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
@@ -2150,6 +2433,11 @@ func (t *txLookup) Add(tx *types.Transaction, local bool) {
 		t.prioritySlots += numSlots(tx)
 		prioritySlotsGauge.Update(int64(t.prioritySlots))
 
+		if isWaiver {
+			t.waivers[tx.Hash()] = waiver
+			t.waiverCounts[waiver]++
+		}
+
 		if local {
 			t.localsPriority[tx.Hash()] = tx
 		} else {
@@ -2159,6 +2447,14 @@ func (t *txLookup) Add(tx *types.Transaction, local bool) {
 		t.slots += numSlots(tx)
 		slotsGauge.Update(int64(t.slots))
 
+		if isSponsored {
+			t.sponsors[tx.Hash()] = sponsor
+			if t.sponsorCost[sponsor] == nil {
+				t.sponsorCost[sponsor] = new(big.Int)
+			}
+			t.sponsorCost[sponsor].Add(t.sponsorCost[sponsor], tx.SponsorCost())
+		}
+
 		if local {
 			t.locals[tx.Hash()] = tx
 		} else {
@@ -2190,9 +2486,23 @@ func (t *txLookup) Remove(hash common.Hash) {
 	if IsPriorityTransaction(tx) {
 		t.prioritySlots -= numSlots(tx)
 		prioritySlotsGauge.Update(int64(t.prioritySlots))
+
+		if waiver, ok := t.waivers[hash]; ok {
+			delete(t.waivers, hash)
+			if t.waiverCounts[waiver]--; t.waiverCounts[waiver] == 0 {
+				delete(t.waiverCounts, waiver)
+			}
+		}
 	} else {
 		t.slots -= numSlots(tx)
 		slotsGauge.Update(int64(t.slots))
+
+		if sponsor, ok := t.sponsors[hash]; ok {
+			delete(t.sponsors, hash)
+			if t.sponsorCost[sponsor].Sub(t.sponsorCost[sponsor], tx.SponsorCost()).Sign() == 0 {
+				delete(t.sponsorCost, sponsor)
+			}
+		}
 	}
 
 	delete(t.locals, hash)
@@ -2201,6 +2511,27 @@ func (t *txLookup) Remove(hash common.Hash) {
 	delete(t.remotesPriority, hash)
 }
 
+// WaiverCount returns the number of gas price waived transactions of the given
+// priority transactor in the lookup.
+func (t *txLookup) WaiverCount(pubkey common.PublicKey) uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.waiverCounts[pubkey]
+}
+
+// SponsorCost returns the gas committed by the given sponsor across the
+// sponsored transactions in the lookup.
+func (t *txLookup) SponsorCost(sponsor common.Address) *big.Int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if cost := t.sponsorCost[sponsor]; cost != nil {
+		return new(big.Int).Set(cost)
+	}
+	return new(big.Int)
+}
+
 // RemoteToLocals migrates the transactions belongs to the given locals to locals
 // set. The assumption is held the locals set is thread-safe to be used.
 func (t *txLookup) RemoteToLocals(locals *accountSet) int {
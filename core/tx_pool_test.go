@@ -25,6 +25,7 @@ import (
 	"math/big"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -56,6 +57,10 @@ var (
 	// eip1559Config is a chain config with EIP-1559 enabled at block 0.
 	eip1559Config *params.ChainConfig
 
+	// sponsoredTxConfig is a chain config with sponsored transactions enabled
+	// at block 0.
+	sponsoredTxConfig *params.ChainConfig
+
 	priorityPrivateKeys [10]*ecdsa.PrivateKey
 )
 
@@ -108,6 +113,10 @@ func init() {
 	eip1559Config.BerlinBlock = common.Big0
 	eip1559Config.LondonBlock = common.Big0
 	eip1559Config.FutureForkBlock = big.NewInt(math.MaxInt64)
+
+	sponsored := *eip1559Config
+	sponsoredTxConfig = &sponsored
+	sponsoredTxConfig.SponsoredTxBlock = common.Big0
 }
 
 type testBlockChain struct {
@@ -128,6 +137,10 @@ func (bc *testBlockChain) GetBlock(hash common.Hash, number uint64) *types.Block
 	return bc.CurrentBlock()
 }
 
+func (bc *testBlockChain) GetReceiptsByHash(hash common.Hash) types.Receipts {
+	return nil
+}
+
 func (bc *testBlockChain) StateAt(common.Hash) (*state.StateDB, error) {
 	return bc.statedb, nil
 }
@@ -290,6 +303,260 @@ func TestWaiverPriorityTxNonZeroFeeRejected(t *testing.T) {
 	}
 }
 
+// Test that the gas price waived transactions of a priority transactor are
+// rejected once they would take it over its pending or gas limits.
+func TestWaiverPriorityTxQuota(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{10000000, statedb, new(event.Feed), WaiverPriorityTx, common.PriorityTransactorMap{}}
+
+	config := testTxPoolConfig
+	config.PriorityQuota = PriorityQuotaConfig{MaxPending: 2, MaxBlockGas: 50_000, MaxWindowGas: 100_000, Window: 10}
+	pool := NewTxPool(config, eip1559Config, blockchain)
+	defer pool.Stop()
+	<-pool.initDoneCh
+
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key1.PublicKey), big.NewInt(params.Ether))
+	testAddBalance(pool, crypto.PubkeyToAddress(key2.PublicKey), big.NewInt(params.Ether))
+
+	// The pending limit applies to the transactor, whatever the sender
+	if err := pool.AddRemote(priorityTx(0, 21_000, big.NewInt(0), big.NewInt(0), key1, priorityPrivateKeys[0])); err != nil {
+		t.Fatalf("failed to add first waived transaction: %v", err)
+	}
+	if err := pool.AddRemote(priorityTx(0, 21_000, big.NewInt(0), big.NewInt(0), key2, priorityPrivateKeys[0])); err != nil {
+		t.Fatalf("failed to add second waived transaction: %v", err)
+	}
+	if err := pool.AddRemote(priorityTx(1, 21_000, big.NewInt(0), big.NewInt(0), key1, priorityPrivateKeys[0])); !errors.Is(err, ErrPriorityQuotaExceeded) {
+		t.Fatalf("pending limit error mismatch: have %v, want %v", err, ErrPriorityQuotaExceeded)
+	}
+	// Other transactors are not affected
+	if err := pool.AddRemote(priorityTx(1, 21_000, big.NewInt(0), big.NewInt(0), key1, priorityPrivateKeys[1])); err != nil {
+		t.Fatalf("failed to add waived transaction of another transactor: %v", err)
+	}
+	// A transaction which can't fit in a block is rejected
+	if err := pool.AddRemote(priorityTx(1, 60_000, big.NewInt(0), big.NewInt(0), key2, priorityPrivateKeys[2])); !errors.Is(err, ErrPriorityQuotaExceeded) {
+		t.Fatalf("block gas limit error mismatch: have %v, want %v", err, ErrPriorityQuotaExceeded)
+	}
+	// So is one which would exceed the gas allowed within the window
+	pubkey := common.BytesToPublicKey(crypto.FromECDSAPub(&priorityPrivateKeys[2].PublicKey))
+	pool.mu.Lock()
+	pool.priorityWindowGas = map[common.PublicKey]uint64{pubkey: 90_000}
+	pool.mu.Unlock()
+
+	if err := pool.AddRemote(priorityTx(1, 21_000, big.NewInt(0), big.NewInt(0), key2, priorityPrivateKeys[2])); !errors.Is(err, ErrPriorityQuotaExceeded) {
+		t.Fatalf("window gas limit error mismatch: have %v, want %v", err, ErrPriorityQuotaExceeded)
+	}
+	// Dropped transactions no longer count towards the pending limit
+	waiver := common.BytesToPublicKey(crypto.FromECDSAPub(&priorityPrivateKeys[0].PublicKey))
+	if n := pool.all.WaiverCount(waiver); n != 2 {
+		t.Fatalf("waived transaction count mismatch: have %d, want %d", n, 2)
+	}
+	dropped := priorityTx(0, 21_000, big.NewInt(0), big.NewInt(0), key2, priorityPrivateKeys[0])
+	pool.mu.Lock()
+	pool.removeTx(dropped.Hash(), true)
+	pool.mu.Unlock()
+
+	if n := pool.all.WaiverCount(waiver); n != 1 {
+		t.Fatalf("waived transaction count mismatch after drop: have %d, want %d", n, 1)
+	}
+	if err := pool.AddRemote(dropped); err != nil {
+		t.Fatalf("failed to add waived transaction after drop: %v", err)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool invariants failed: %v", err)
+	}
+}
+
+// Tests that priority transactions signed under the pre-fork priority signature
+// scheme are only accepted after the future fork within the grace window, and
+// that they are flagged as needing a new priority signature.
+func TestPrioritySigGraceWindow(t *testing.T) {
+	t.Parallel()
+
+	for _, grace := range []uint64{0, 2} {
+		config := *params.TestChainConfig
+		config.FutureForkBlock = big.NewInt(0)
+		config.PrioritySigGraceBlocks = grace
+
+		pool, key := setupTxPoolWithConfig(&config)
+		testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+		// A legacy priority signature is only accepted within the grace window
+		err := pool.addRemoteSync(priorityTx(0, 100000, big.NewInt(1), big.NewInt(1), key, priorityPrivateKeys[0]))
+		if grace == 0 {
+			if !errors.Is(err, errBadPriorityKey) {
+				t.Fatalf("grace %d: expected %v, got %v", grace, errBadPriorityKey, err)
+			}
+			pool.Stop()
+			continue
+		}
+		if err != nil {
+			t.Fatalf("grace %d: failed to add legacy priority transaction: %v", grace, err)
+		}
+		// A sender-bound priority signature is accepted regardless
+		bound, _ := types.SignNewPriorityTx(key, priorityPrivateKeys[0], types.NewFutureForkSigner(config.ChainID), &types.PriorityTx{
+			ChainID:   config.ChainID,
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(1),
+			Gas:       100000,
+			To:        &common.Address{},
+			Value:     big.NewInt(100),
+		})
+		if err := pool.addRemoteSync(bound); err != nil {
+			t.Fatalf("grace %d: failed to add sender-bound priority transaction: %v", grace, err)
+		}
+		// Only the legacy one needs a new priority signature
+		migrations := pool.PriorityMigration()
+		if len(migrations) != 2 {
+			t.Fatalf("grace %d: migration report size mismatch: have %d, want %d", grace, len(migrations), 2)
+		}
+		for _, migration := range migrations {
+			if want := migration.Tx.Nonce() == 0; migration.Affected() != want {
+				t.Errorf("grace %d: nonce %d affected mismatch: have %v, want %v", grace, migration.Tx.Nonce(), migration.Affected(), want)
+			}
+		}
+		if err := validateTxPoolInternals(pool); err != nil {
+			t.Fatalf("grace %d: pool internal state corrupted: %v", grace, err)
+		}
+		pool.Stop()
+	}
+}
+
+// Test that the gas of sponsored transactions is checked against the balance
+// of the sponsor, the value against the balance of the sender, and that the
+// transactions are rejected before their fork.
+func TestSponsoredTxSponsorFunds(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPoolWithConfig(sponsoredTxConfig)
+	defer pool.Stop()
+
+	sponsorKey, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	sponsor := crypto.PubkeyToAddress(sponsorKey.PublicKey)
+
+	// The sender only needs to afford the value
+	testAddBalance(pool, from, big.NewInt(1000))
+
+	if err := pool.AddRemote(sponsoredTx(0, 100000, big.NewInt(1), big.NewInt(1), key, sponsorKey)); !errors.Is(err, ErrInsufficientSponsorFunds) {
+		t.Fatalf("expected %v, got %v", ErrInsufficientSponsorFunds, err)
+	}
+	testAddBalance(pool, sponsor, big.NewInt(250000))
+	if err := pool.AddRemote(sponsoredTx(0, 100000, big.NewInt(1), big.NewInt(1), key, sponsorKey)); err != nil {
+		t.Fatalf("expected sponsored tx to be accepted, got %v", err)
+	}
+	// The sponsor can't pay for a second transaction as well
+	if err := pool.AddRemote(sponsoredTx(1, 100000, big.NewInt(2), big.NewInt(1), key, sponsorKey)); !errors.Is(err, ErrInsufficientSponsorFunds) {
+		t.Fatalf("expected %v, got %v", ErrInsufficientSponsorFunds, err)
+	}
+	// But it can pay for a replacement
+	replacement := sponsoredTx(0, 100000, big.NewInt(2), big.NewInt(2), key, sponsorKey)
+	if err := pool.addRemoteSync(replacement); err != nil {
+		t.Fatalf("expected sponsored replacement to be accepted, got %v", err)
+	}
+	if cost := pool.all.SponsorCost(sponsor); cost.Cmp(replacement.SponsorCost()) != 0 {
+		t.Fatalf("sponsor commitment mismatch: have %v, want %v", cost, replacement.SponsorCost())
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool invariants failed: %v", err)
+	}
+	// Sponsored transactions are dropped once their sponsor can no longer pay
+	pool.mu.Lock()
+	pool.currentState.SetBalance(sponsor, big.NewInt(1))
+	pool.demoteUnexecutables()
+	pool.mu.Unlock()
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("pending/queued mismatch: have %d/%d, want 0/0", pending, queued)
+	}
+	if cost := pool.all.SponsorCost(sponsor); cost.Sign() != 0 {
+		t.Fatalf("sponsor commitment left after drop: %v", cost)
+	}
+	// Sponsored transactions are rejected before their fork
+	pool.sponsored = false
+	if err := pool.AddRemote(sponsoredTx(0, 100000, big.NewInt(1), big.NewInt(1), key, sponsorKey)); !errors.Is(err, ErrTxTypeNotSupported) {
+		t.Fatalf("expected %v, got %v", ErrTxTypeNotSupported, err)
+	}
+}
+
+// Test that validating transactions reports the outcome of the individual
+// checks without adding them to the pool.
+func TestValidateTxReport(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPoolWithConfig(eip1559Config)
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(params.Ether))
+
+	baseFee := big.NewInt(1_000_000_000)
+	pool.priced.urgent.baseFee = new(big.Int).Set(baseFee)
+
+	// A valid priority transaction two nonces ahead
+	report := pool.Validate(priorityTx(2, 21_000, baseFee, big.NewInt(1), key, priorityPrivateKeys[0]), false)
+	if report.Err != nil {
+		t.Fatalf("expected valid transaction, got %v", report.Err)
+	}
+	if report.Sender != from || report.SenderErr != nil {
+		t.Errorf("wrong sender: have %x, %v", report.Sender, report.SenderErr)
+	}
+	pubkey := common.BytesToPublicKey(crypto.FromECDSAPub(&priorityPrivateKeys[0].PublicKey))
+	if report.PriorityPubkey == nil || *report.PriorityPubkey != pubkey || report.Transactor == nil {
+		t.Errorf("wrong priority transactor: have %v, %v", report.PriorityPubkey, report.Transactor)
+	}
+	if report.Transactor != nil && report.Transactor.IsGasPriceWaiver {
+		t.Errorf("unexpected gas price waiver")
+	}
+	if report.PrioritySigner != "london" {
+		t.Errorf("wrong priority signer: have %s, want london", report.PrioritySigner)
+	}
+	if report.StateNonce != 0 || report.PendingNonce != 0 {
+		t.Errorf("wrong nonces: have %d/%d, want 0/0", report.StateNonce, report.PendingNonce)
+	}
+	if report.BaseFee == nil || report.BaseFee.Cmp(baseFee) != 0 {
+		t.Errorf("wrong base fee: have %v, want %v", report.BaseFee, baseFee)
+	}
+	if report.IntrinsicGas != params.TxGas || report.IntrinsicErr != nil {
+		t.Errorf("wrong intrinsic gas: have %d, %v", report.IntrinsicGas, report.IntrinsicErr)
+	}
+	// A priority transaction below the base fee signed with an unknown key
+	unknown, _ := crypto.GenerateKey()
+	report = pool.Validate(priorityTx(0, 20_000, big.NewInt(1), big.NewInt(1), key, unknown), false)
+	if !errors.Is(report.Err, errBadPriorityKey) || !errors.Is(report.PriorityErr, errBadPriorityKey) {
+		t.Errorf("expected %v, got %v and %v", errBadPriorityKey, report.Err, report.PriorityErr)
+	}
+	if report.PriorityPubkey == nil || report.Transactor != nil {
+		t.Errorf("expected unknown priority key to be reported without transactor")
+	}
+	if !errors.Is(report.IntrinsicErr, ErrIntrinsicGas) {
+		t.Errorf("expected %v, got %v", ErrIntrinsicGas, report.IntrinsicErr)
+	}
+	// A priority transaction of a known key below the base fee
+	report = pool.Validate(priorityTx(0, 21_000, big.NewInt(1), big.NewInt(1), key, priorityPrivateKeys[0]), false)
+	if !errors.Is(report.Err, ErrFeeCapTooLow) || !errors.Is(report.FeeErr, ErrFeeCapTooLow) {
+		t.Errorf("expected %v, got %v and %v", ErrFeeCapTooLow, report.Err, report.FeeErr)
+	}
+	// Nothing was added to the pool
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("pending/queued mismatch: have %d/%d, want 0/0", pending, queued)
+	}
+	// Known transactions are reported as such
+	tx := dynamicFeeTx(0, 21_000, baseFee, big.NewInt(1), key)
+	if err := pool.addRemoteSync(tx); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	if report = pool.Validate(tx, false); !report.Known || !errors.Is(report.Err, ErrAlreadyKnown) {
+		t.Errorf("expected known transaction, got %v", report.Err)
+	}
+	if report.PendingNonce != 1 {
+		t.Errorf("wrong pending nonce: have %d, want 1", report.PendingNonce)
+	}
+}
+
 // Test that a non-waiver priority tx with zero fees is rejected with
 // errNoGasPriceWaiver (the original rule), even before the base fee
 // comparison is reached.
@@ -392,6 +659,21 @@ func dynamicFeeTx(nonce uint64, gaslimit uint64, gasFee *big.Int, tip *big.Int,
 	return tx
 }
 
+func sponsoredTx(nonce uint64, gaslimit uint64, gasFee *big.Int, tip *big.Int, key *ecdsa.PrivateKey, sponsorKey *ecdsa.PrivateKey) *types.Transaction {
+	tx, _ := types.SignNewSponsoredTx(key, sponsorKey, types.LatestSignerForChainID(params.TestChainConfig.ChainID), &types.SponsoredTx{
+		ChainID:    params.TestChainConfig.ChainID,
+		Nonce:      nonce,
+		GasTipCap:  tip,
+		GasFeeCap:  gasFee,
+		Gas:        gaslimit,
+		To:         &common.Address{},
+		Value:      big.NewInt(100),
+		Data:       nil,
+		AccessList: nil,
+	})
+	return tx
+}
+
 func priorityDataTransaction(nonce uint64, gaslimit uint64, gasFee *big.Int, tip *big.Int, key *ecdsa.PrivateKey, priorityKey *ecdsa.PrivateKey, bytes uint64) *types.Transaction {
 	data := make([]byte, bytes)
 	crand.Read(data)
@@ -4164,6 +4446,77 @@ func testTransactionJournaling(t *testing.T, nolocals bool) {
 	pool.Stop()
 }
 
+// Tests that remote priority transactions are journaled to disk and reloaded
+// after a restart, and that the ones whose priority key was revoked in the
+// meantime are dropped on reload.
+func TestPriorityTransactionJournaling(t *testing.T) {
+	t.Parallel()
+
+	journal := filepath.Join(t.TempDir(), "priority_transactions.rlp")
+
+	// Create the original pool to inject transaction into the journal
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{1000000, statedb, new(event.Feed), NonWaiverPriorityTx, common.PriorityTransactorMap{}}
+
+	config := testTxPoolConfig
+	config.PriorityJournal = journal
+	config.Rejournal = time.Second
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	priority := priorityPrivateKeys[1]
+	remote, _ := crypto.GenerateKey()
+
+	testAddBalance(pool, crypto.PubkeyToAddress(priority.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(remote.PublicKey), big.NewInt(1000000000))
+
+	// Add two priority and a plain remote transaction and ensure they are pooled
+	if err := pool.addRemoteSync(priorityTx(0, 100000, big.NewInt(1), big.NewInt(1), priority, priority)); err != nil {
+		t.Fatalf("failed to add priority transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(priorityTx(1, 100000, big.NewInt(1), big.NewInt(1), priority, priority)); err != nil {
+		t.Fatalf("failed to add priority transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(1), remote)); err != nil {
+		t.Fatalf("failed to add remote transaction: %v", err)
+	}
+	if pending, _ := pool.Stats(); pending != 3 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 3)
+	}
+	// Restart the pool and ensure only the priority transactions survive
+	pool.Stop()
+	blockchain = &testBlockChain{1000000, statedb, new(event.Feed), NonWaiverPriorityTx, common.PriorityTransactorMap{}}
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	pending, queued := pool.Stats()
+	if pending != 2 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 2)
+	}
+	if queued != 0 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 0)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// Revoke the priority keys and ensure the journaled transactions are dropped
+	pool.Stop()
+	blockchain = &testBlockChain{1000000, statedb, new(event.Feed), NoPriorityTx, common.PriorityTransactorMap{}}
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	if pending, queued = pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("transactions mismatched: have %d/%d, want %d/%d", pending, queued, 0, 0)
+	}
+	// Reinstate the keys and ensure the dropped transactions were rotated out
+	pool.Stop()
+	blockchain = &testBlockChain{1000000, statedb, new(event.Feed), NonWaiverPriorityTx, common.PriorityTransactorMap{}}
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	if pending, queued = pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("transactions mismatched: have %d/%d, want %d/%d", pending, queued, 0, 0)
+	}
+	pool.Stop()
+}
+
 // TestTransactionStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestTransactionStatusCheck(t *testing.T) {
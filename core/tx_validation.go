@@ -0,0 +1,120 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/types"
+)
+
+// TxValidation is the report of running the pool checks against a transaction
+// without adding it to the pool. Err holds the error the pool would refuse the
+// transaction with, the other fields explain how it got there.
+type TxValidation struct {
+	Known bool // Whether the transaction is already in the pool
+
+	Sender         common.Address
+	SenderErr      error
+	PrioritySigner string // Fork of the signer recovering priority keys
+	PriorityPubkey *common.PublicKey
+	PriorityErr    error
+	Transactor     *common.PriorityTransactor
+	QuotaErr       error
+	Sponsor        *common.Address
+	SponsorErr     error
+
+	FeeErr  error
+	BaseFee *big.Int // Base fee priority transactions without waiver must pay
+	MinTip  *big.Int // Minimal tip of remote transactions
+
+	StateNonce   uint64
+	PendingNonce uint64
+	Balance      *big.Int
+	Cost         *big.Int
+
+	IntrinsicGas uint64
+	IntrinsicErr error
+
+	Err error
+}
+
+// Validate runs the pool checks against a transaction without adding it to
+// the pool, and reports the outcome of each of them.
+func (pool *TxPool) Validate(tx *types.Transaction, local bool) *TxValidation {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	report := &TxValidation{
+		Known:          pool.all.Get(tx.Hash()) != nil,
+		PrioritySigner: "london",
+		MinTip:         new(big.Int).Set(pool.gasPrice),
+		Cost:           tx.Cost(),
+	}
+	if pool.futureFork {
+		report.PrioritySigner = "futureFork"
+	}
+	if bf := pool.priced.urgent.baseFee; pool.eip1559 && bf != nil {
+		report.BaseFee = new(big.Int).Set(bf)
+	}
+	isLocal := local || pool.locals.containsTx(tx)
+	if report.Known {
+		report.Err = ErrAlreadyKnown
+	} else {
+		report.Err = pool.validateTx(tx, isLocal)
+	}
+	report.Sender, report.SenderErr = types.Sender(pool.signer, tx)
+	if report.SenderErr != nil {
+		report.SenderErr = ErrInvalidSender
+	}
+	// Report the priority transactor, and the quota of gas price waived ones
+	var transactor *common.PriorityTransactor
+	if tx.Type() == types.PriorityTxType {
+		pubkey, allowed, err := pool.priorityTransactor(tx)
+		if err != errBadPrioritySignature {
+			report.PriorityPubkey = &pubkey
+		}
+		if report.PriorityErr = err; err == nil {
+			transactor = &allowed
+			report.Transactor = transactor
+			if allowed.IsGasPriceWaiver && report.SenderErr == nil {
+				report.QuotaErr = pool.validatePriorityQuota(tx, report.Sender, pubkey, allowed)
+			}
+		}
+	}
+	if tx.Type() == types.SponsoredTxType {
+		if sponsor, err := types.Sponsor(pool.signer, tx); err == nil {
+			report.Sponsor = &sponsor
+		}
+		if report.SenderErr == nil {
+			report.SponsorErr = pool.validateSponsor(tx, report.Sender)
+		}
+	}
+	report.FeeErr = pool.validateFees(tx, isLocal, transactor)
+
+	if report.SenderErr == nil {
+		report.StateNonce = pool.currentState.GetNonce(report.Sender)
+		report.PendingNonce = pool.pendingNonces.get(report.Sender)
+		report.Balance = pool.currentState.GetBalance(report.Sender)
+	}
+	report.IntrinsicGas, report.IntrinsicErr = IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, pool.istanbul)
+	if report.IntrinsicErr == nil && tx.Gas() < report.IntrinsicGas {
+		report.IntrinsicErr = ErrIntrinsicGas
+	}
+	return report
+}
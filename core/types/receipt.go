@@ -64,6 +64,10 @@ type Receipt struct {
 	ContractAddress common.Address `json:"contractAddress"`
 	GasUsed         uint64         `json:"gasUsed" gencodec:"required"`
 
+	// Payer is the sponsor who paid the fee of a sponsored transaction. It is
+	// derived from the transaction and not stored in the chain database.
+	Payer *common.Address `json:"payer,omitempty"`
+
 	// Inclusion information: These fields provide information about the inclusion of the
 	// transaction corresponding to this receipt.
 	BlockHash        common.Hash `json:"blockHash,omitempty"`
@@ -214,7 +218,7 @@ func (r *Receipt) decodeTyped(b []byte) error {
 		return errShortTypedReceipt
 	}
 	switch b[0] {
-	case DynamicFeeTxType, PriorityTxType, AccessListTxType:
+	case DynamicFeeTxType, PriorityTxType, SponsoredTxType, AccessListTxType:
 		var data receiptRLP
 		err := rlp.DecodeBytes(b[1:], &data)
 		if err != nil {
@@ -390,6 +394,9 @@ func (rs Receipts) EncodeIndex(i int, w *bytes.Buffer) {
 	case PriorityTxType:
 		w.WriteByte(PriorityTxType)
 		rlp.Encode(w, data)
+	case SponsoredTxType:
+		w.WriteByte(SponsoredTxType)
+		rlp.Encode(w, data)
 	default:
 		// For unsupported types, write nothing. Since this is for
 		// DeriveSha, the error will be caught matching the derived hash
@@ -422,6 +429,12 @@ func (rs Receipts) DeriveFields(config *params.ChainConfig, hash common.Hash, nu
 			from, _ := Sender(signer, txs[i])
 			rs[i].ContractAddress = crypto.CreateAddress(from, txs[i].Nonce())
 		}
+		// The fee payer of a sponsored transaction is its sponsor
+		if txs[i].Type() == SponsoredTxType {
+			if sponsor, err := Sponsor(signer, txs[i]); err == nil {
+				rs[i].Payer = &sponsor
+			}
+		}
 		// The used gas can be calculated based on previous r
 		if i == 0 {
 			rs[i].GasUsed = rs[i].CumulativeGasUsed
@@ -45,7 +45,8 @@ const (
 	LegacyTxType = iota
 	AccessListTxType
 	DynamicFeeTxType
-	PriorityTxType = 64 // the implementation stops at 128
+	PriorityTxType  = 64 // the implementation stops at 128
+	SponsoredTxType = 65
 )
 
 // Transaction is an Ethereum transaction.
@@ -58,6 +59,7 @@ type Transaction struct {
 	size           atomic.Value
 	from           atomic.Value
 	priorityPubkey atomic.Value
+	sponsor        atomic.Value
 }
 
 // NewTx creates a new transaction.
@@ -69,7 +71,7 @@ func NewTx(inner TxData) *Transaction {
 
 // TxData is the underlying data of a transaction.
 //
-// This is implemented by DynamicFeeTx, LegacyTx and AccessListTx and PriorityTx
+// This is implemented by DynamicFeeTx, LegacyTx, AccessListTx, PriorityTx and SponsoredTx
 type TxData interface {
 	txType() byte // returns the type ID
 	copy() TxData // creates a deep copy and initializes all fields
@@ -190,6 +192,10 @@ func (tx *Transaction) decodeTyped(b []byte) (TxData, error) {
 		var inner PriorityTx
 		err := rlp.DecodeBytes(b[1:], &inner)
 		return &inner, err
+	case SponsoredTxType:
+		var inner SponsoredTx
+		err := rlp.DecodeBytes(b[1:], &inner)
+		return &inner, err
 	default:
 		return nil, ErrTxTypeNotSupported
 	}
@@ -298,13 +304,27 @@ func (tx *Transaction) To() *common.Address {
 	return copyAddressPtr(tx.inner.to())
 }
 
-// Cost returns gas * gasPrice + value.
+// Cost returns gas * gasPrice + value, the amount charged to the sender.
+// The fee of a sponsored transaction is paid by its sponsor, so only the
+// value is charged to the sender.
 func (tx *Transaction) Cost() *big.Int {
+	if tx.Type() == SponsoredTxType {
+		return tx.Value()
+	}
 	total := new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))
 	total.Add(total, tx.Value())
 	return total
 }
 
+// SponsorCost returns gas * gasPrice, the amount charged to the sponsor of a
+// sponsored transaction. It is zero for every other transaction type.
+func (tx *Transaction) SponsorCost() *big.Int {
+	if tx.Type() != SponsoredTxType {
+		return new(big.Int)
+	}
+	return new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))
+}
+
 // RawSignatureValues returns the V, R, S signature values of the transaction.
 // The return values should not be modified by the caller.
 func (tx *Transaction) RawSignatureValues() (v, r, s *big.Int) {
@@ -320,6 +340,18 @@ func (tx *Transaction) RawPrioritySignatureValues() (v, r, s *big.Int) {
 	}
 }
 
+// RawSponsorSignatureValues returns the V, R, S sponsor signature values of a
+// sponsored transaction, or nils for every other transaction type.
+// The return values should not be modified by the caller.
+func (tx *Transaction) RawSponsorSignatureValues() (v, r, s *big.Int) {
+	switch inner := tx.inner.(type) {
+	case *SponsoredTx:
+		return inner.rawSponsorSignatureValues()
+	default:
+		return nil, nil, nil
+	}
+}
+
 // GasFeeCapCmp compares the fee cap of two transactions.
 func (tx *Transaction) GasFeeCapCmp(other *Transaction) int {
 	return tx.inner.gasFeeCap().Cmp(other.inner.gasFeeCap())
@@ -436,6 +468,22 @@ func (tx *Transaction) WithPrioritySignature(signer Signer, sig []byte) (*Transa
 	return &Transaction{inner: cpy, time: tx.time}, nil
 }
 
+// WithSponsorSignature returns a new sponsored transaction with the given
+// sponsor signature. This signature needs to be in the [R || S || V] format
+// where V is 0 or 1.
+func (tx *Transaction) WithSponsorSignature(signer Signer, sig []byte) (*Transaction, error) {
+	if tx.Type() != SponsoredTxType {
+		return nil, ErrTxTypeNotSupported
+	}
+	r, s, v, err := signer.SignatureValues(tx, sig)
+	if err != nil {
+		return nil, err
+	}
+	cpy := tx.inner.copy().(*SponsoredTx)
+	cpy.setSponsorSignatureValues(signer.ChainID(), v, r, s)
+	return &Transaction{inner: cpy, time: tx.time}, nil
+}
+
 // Transactions implements DerivableList for transactions.
 type Transactions []*Transaction
 
@@ -610,6 +658,7 @@ type Message struct {
 	gasFeeCap      *big.Int
 	gasTipCap      *big.Int
 	prioritySender common.PublicKey
+	sponsor        *common.Address
 	data           []byte
 	accessList     AccessList
 	isFake         bool
@@ -660,17 +709,40 @@ func (tx *Transaction) AsMessage(s Signer, baseFee *big.Int) (Message, error) {
 			return msg, err //is this ok?
 		}
 	}
+	if tx.Type() == SponsoredTxType {
+		sponsor, err := Sponsor(s, tx)
+		if err != nil {
+			return msg, err
+		}
+		msg.sponsor = &sponsor
+	}
 
 	msg.from, err = Sender(s, tx) //very important point: this IS the transaction signature verification. txes derive 'from' from the tx signature. sender() gets the sender and verifies the signature in one go
 	return msg, err               // is this ok?
 }
 
+// WithPrioritySender returns a copy of the message with its priority sender
+// replaced, used when the priority public key was resolved under another
+// priority signature scheme than the one of the message signer.
+func (m Message) WithPrioritySender(pubkey common.PublicKey) Message {
+	m.prioritySender = pubkey
+	return m
+}
+
+// WithGas returns a copy of the message with its gas limit replaced, used when
+// capping the gas of a transaction executed over RPC.
+func (m Message) WithGas(gas uint64) Message {
+	m.gasLimit = gas
+	return m
+}
+
 func (m Message) From() common.Address             { return m.from }
 func (m Message) To() *common.Address              { return m.to }
 func (m Message) GasPrice() *big.Int               { return m.gasPrice }
 func (m Message) GasFeeCap() *big.Int              { return m.gasFeeCap }
 func (m Message) GasTipCap() *big.Int              { return m.gasTipCap }
 func (m Message) PrioritySender() common.PublicKey { return m.prioritySender }
+func (m Message) Sponsor() *common.Address         { return m.sponsor }
 func (m Message) Value() *big.Int                  { return m.amount }
 func (m Message) Gas() uint64                      { return m.gasLimit }
 func (m Message) Nonce() uint64                    { return m.nonce }
@@ -43,6 +43,9 @@ type txJSON struct {
 	PriorityV            *hexutil.Big    `json:"priorityV"`
 	PriorityR            *hexutil.Big    `json:"priorityR"`
 	PriorityS            *hexutil.Big    `json:"priorityS"`
+	SponsorV             *hexutil.Big    `json:"sponsorV,omitempty"`
+	SponsorR             *hexutil.Big    `json:"sponsorR,omitempty"`
+	SponsorS             *hexutil.Big    `json:"sponsorS,omitempty"`
 	To                   *common.Address `json:"to"`
 
 	// Access list transaction fields:
@@ -113,6 +116,22 @@ func (t *Transaction) MarshalJSON() ([]byte, error) {
 		enc.PriorityV = (*hexutil.Big)(tx.PriorityV)
 		enc.PriorityR = (*hexutil.Big)(tx.PriorityR)
 		enc.PriorityS = (*hexutil.Big)(tx.PriorityS)
+	case *SponsoredTx:
+		enc.ChainID = (*hexutil.Big)(tx.ChainID)
+		enc.AccessList = &tx.AccessList
+		enc.Nonce = (*hexutil.Uint64)(&tx.Nonce)
+		enc.Gas = (*hexutil.Uint64)(&tx.Gas)
+		enc.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap)
+		enc.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap)
+		enc.Value = (*hexutil.Big)(tx.Value)
+		enc.Data = (*hexutil.Bytes)(&tx.Data)
+		enc.To = t.To()
+		enc.V = (*hexutil.Big)(tx.V)
+		enc.R = (*hexutil.Big)(tx.R)
+		enc.S = (*hexutil.Big)(tx.S)
+		enc.SponsorV = (*hexutil.Big)(tx.SponsorV)
+		enc.SponsorR = (*hexutil.Big)(tx.SponsorR)
+		enc.SponsorS = (*hexutil.Big)(tx.SponsorS)
 	}
 	return json.Marshal(&enc)
 }
@@ -357,6 +376,81 @@ func (t *Transaction) UnmarshalJSON(input []byte) error {
 			}
 		}
 
+	case SponsoredTxType:
+		var itx SponsoredTx
+		inner = &itx
+		// Access list is optional for now.
+		if dec.AccessList != nil {
+			itx.AccessList = *dec.AccessList
+		}
+		if dec.ChainID == nil {
+			return errors.New("missing required field 'chainId' in transaction")
+		}
+		itx.ChainID = (*big.Int)(dec.ChainID)
+		if dec.To != nil {
+			itx.To = dec.To
+		}
+		if dec.Nonce == nil {
+			return errors.New("missing required field 'nonce' in transaction")
+		}
+		itx.Nonce = uint64(*dec.Nonce)
+		if dec.MaxPriorityFeePerGas == nil {
+			return errors.New("missing required field 'maxPriorityFeePerGas' for txdata")
+		}
+		itx.GasTipCap = (*big.Int)(dec.MaxPriorityFeePerGas)
+		if dec.MaxFeePerGas == nil {
+			return errors.New("missing required field 'maxFeePerGas' for txdata")
+		}
+		itx.GasFeeCap = (*big.Int)(dec.MaxFeePerGas)
+		if dec.Gas == nil {
+			return errors.New("missing required field 'gas' for txdata")
+		}
+		itx.Gas = uint64(*dec.Gas)
+		if dec.Value == nil {
+			return errors.New("missing required field 'value' in transaction")
+		}
+		itx.Value = (*big.Int)(dec.Value)
+		if dec.Data == nil {
+			return errors.New("missing required field 'input' in transaction")
+		}
+		itx.Data = *dec.Data
+		if dec.V == nil {
+			return errors.New("missing required field 'v' in transaction")
+		}
+		itx.V = (*big.Int)(dec.V)
+		if dec.R == nil {
+			return errors.New("missing required field 'r' in transaction")
+		}
+		itx.R = (*big.Int)(dec.R)
+		if dec.S == nil {
+			return errors.New("missing required field 's' in transaction")
+		}
+		itx.S = (*big.Int)(dec.S)
+		withSignature := itx.V.Sign() != 0 || itx.R.Sign() != 0 || itx.S.Sign() != 0
+		if withSignature {
+			if err := sanityCheckSignature(itx.V, itx.R, itx.S, false); err != nil {
+				return err
+			}
+		}
+		if dec.SponsorV == nil {
+			return errors.New("missing required field 'sponsorV' in transaction")
+		}
+		itx.SponsorV = (*big.Int)(dec.SponsorV)
+		if dec.SponsorR == nil {
+			return errors.New("missing required field 'sponsorR' in transaction")
+		}
+		itx.SponsorR = (*big.Int)(dec.SponsorR)
+		if dec.SponsorS == nil {
+			return errors.New("missing required field 'sponsorS' in transaction")
+		}
+		itx.SponsorS = (*big.Int)(dec.SponsorS)
+		withSponsorSignature := itx.SponsorV.Sign() != 0 || itx.SponsorR.Sign() != 0 || itx.SponsorS.Sign() != 0
+		if withSponsorSignature {
+			if err := sanityCheckSignature(itx.SponsorV, itx.SponsorR, itx.SponsorS, false); err != nil {
+				return err
+			}
+		}
+
 	default:
 		return ErrTxTypeNotSupported
 	}
@@ -29,6 +29,8 @@ import (
 
 var ErrInvalidChainId = errors.New("invalid chain id for signer")
 var ErrTxIsNotPriorityType = errors.New("tx is not priority transaction")
+var ErrTxIsNotSenderSigned = errors.New("priority transaction is not signed by its sender")
+var ErrTxIsNotSponsoredType = errors.New("tx is not sponsored transaction")
 
 // sigCache is used to cache the derived sender and contains
 // the signer used to derive it.
@@ -135,6 +137,24 @@ func SignPriorityTx(tx *Transaction, s Signer, prv *ecdsa.PrivateKey, priorityPr
 	return txCpy.WithPrioritySignature(s, prioritySig)
 }
 
+// AddPrioritySignature signs a priority transaction already signed by its sender
+// with the given priority key, allowing both signatures to be produced by
+// different parties.
+func AddPrioritySignature(tx *Transaction, s Signer, priorityPrv *ecdsa.PrivateKey) (*Transaction, error) {
+	if tx.Type() != PriorityTxType {
+		return nil, ErrTxIsNotPriorityType
+	}
+	if _, r, _ := tx.RawSignatureValues(); r == nil || r.Sign() == 0 {
+		return nil, ErrTxIsNotSenderSigned
+	}
+	ph := s.PriorityHash(tx)
+	prioritySig, err := crypto.Sign(ph[:], priorityPrv)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithPrioritySignature(s, prioritySig)
+}
+
 // SignNewTx creates a transaction and signs it.
 func SignNewTx(prv *ecdsa.PrivateKey, s Signer, txdata TxData) (*Transaction, error) {
 	tx := NewTx(txdata)
@@ -171,6 +191,49 @@ func SignNewPriorityTx(prv *ecdsa.PrivateKey, priorityPrv *ecdsa.PrivateKey, s S
 	return txCpy.WithPrioritySignature(s, prioritySig)
 }
 
+// SignSponsoredTx signs a sponsored transaction with the sender key over Hash(tx),
+// then with the sponsor key over SponsorHash(tx), which includes the sender's
+// V,R,S so the sponsor only pays for this exact transaction.
+func SignSponsoredTx(tx *Transaction, s Signer, prv *ecdsa.PrivateKey, sponsorPrv *ecdsa.PrivateKey) (*Transaction, error) {
+	if tx.Type() != SponsoredTxType {
+		return nil, ErrTxIsNotSponsoredType
+	}
+	h := s.Hash(tx)
+	sig, err := crypto.Sign(h[:], prv)
+	if err != nil {
+		return nil, err
+	}
+	txCpy, err := tx.WithSignature(s, sig)
+	if err != nil {
+		return nil, err
+	}
+	return AddSponsorSignature(txCpy, s, sponsorPrv)
+}
+
+// AddSponsorSignature signs a sponsored transaction already signed by its
+// sender with the given sponsor key, allowing both signatures to be produced
+// by different parties.
+func AddSponsorSignature(tx *Transaction, s Signer, sponsorPrv *ecdsa.PrivateKey) (*Transaction, error) {
+	if tx.Type() != SponsoredTxType {
+		return nil, ErrTxIsNotSponsoredType
+	}
+	if _, r, _ := tx.RawSignatureValues(); r == nil || r.Sign() == 0 {
+		return nil, ErrTxIsNotSenderSigned
+	}
+	sh := s.SponsorHash(tx)
+	sponsorSig, err := crypto.Sign(sh[:], sponsorPrv)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSponsorSignature(s, sponsorSig)
+}
+
+// SignNewSponsoredTx creates a sponsored transaction and signs it with both
+// the sender and the sponsor key.
+func SignNewSponsoredTx(prv *ecdsa.PrivateKey, sponsorPrv *ecdsa.PrivateKey, s Signer, txdata TxData) (*Transaction, error) {
+	return SignSponsoredTx(NewTx(txdata), s, prv, sponsorPrv)
+}
+
 // MustSignNewTx creates a transaction and signs it.
 // This panics if the transaction cannot be signed.
 func MustSignNewTx(prv *ecdsa.PrivateKey, s Signer, txdata TxData) *Transaction {
@@ -226,6 +289,28 @@ func PrioritySender(signer Signer, tx *Transaction) (common.PublicKey, error) {
 	return pub, nil
 }
 
+// Sponsor returns the address of the account paying the fee of a sponsored
+// transaction, derived from the sponsor signature.
+//
+// Sponsor may cache the address, allowing it to be used regardless of
+// signing method. The cache is invalidated if the cached signer does
+// not match the signer used in the current call.
+func Sponsor(signer Signer, tx *Transaction) (common.Address, error) {
+	if sc := tx.sponsor.Load(); sc != nil {
+		sigCache := sc.(sigCache)
+		if sigCache.signer.Equal(signer) {
+			return sigCache.from, nil
+		}
+	}
+
+	addr, err := signer.Sponsor(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	tx.sponsor.Store(sigCache{signer: signer, from: addr})
+	return addr, nil
+}
+
 // Signer encapsulates transaction signature handling. The name of this type is slightly
 // misleading because Signers don't actually sign, they're just for validating and
 // processing of signatures.
@@ -239,6 +324,9 @@ type Signer interface {
 	// PrioritySender returns the secp256k1 pubkey of a priority sender
 	PrioritySender(tx *Transaction) (common.PublicKey, error)
 
+	// Sponsor returns the address paying the fee of a sponsored transaction.
+	Sponsor(tx *Transaction) (common.Address, error)
+
 	// SignatureValues returns the raw R, S, V values corresponding to the
 	// given signature.
 	SignatureValues(tx *Transaction, sig []byte) (r, s, v *big.Int, err error)
@@ -254,6 +342,11 @@ type Signer interface {
 	// is bound to a specific sender and cannot be replayed.
 	PriorityHash(tx *Transaction) common.Hash
 
+	// SponsorHash returns the hash to be signed by the sponsor. It includes
+	// the sender's V, R, S so the sponsor signature cannot be reused for a
+	// different sender.
+	SponsorHash(tx *Transaction) common.Hash
+
 	// Equal returns true if the given signer is the same as the receiver.
 	Equal(Signer) bool
 }
@@ -270,7 +363,7 @@ func NewLondonSigner(chainId *big.Int) Signer {
 }
 
 func (s londonSigner) Sender(tx *Transaction) (common.Address, error) {
-	if tx.Type() != DynamicFeeTxType && tx.Type() != PriorityTxType {
+	if tx.Type() != DynamicFeeTxType && tx.Type() != PriorityTxType && tx.Type() != SponsoredTxType {
 		return s.eip2930Signer.Sender(tx)
 	}
 	V, R, S := tx.RawSignatureValues()
@@ -287,6 +380,12 @@ func (s londonSigner) Sender(tx *Transaction) (common.Address, error) {
 			return common.Address{}, err
 		}
 	}
+	// And the sponsor signature
+	if tx.Type() == SponsoredTxType {
+		if _, err := s.Sponsor(tx); err != nil {
+			return common.Address{}, err
+		}
+	}
 	return recoverPlain(s.Hash(tx), R, S, V, true)
 }
 
@@ -306,6 +405,21 @@ func (s londonSigner) PrioritySender(tx *Transaction) (common.PublicKey, error)
 	}
 }
 
+// Sponsor recovers the address of the sponsor of a sponsored transaction.
+func (s londonSigner) Sponsor(tx *Transaction) (common.Address, error) {
+	switch inner := tx.inner.(type) {
+	case *SponsoredTx:
+		V, R, S := inner.rawSponsorSignatureValues()
+		V = new(big.Int).Add(V, big.NewInt(27))
+		if tx.ChainId().Cmp(s.chainId) != 0 {
+			return common.Address{}, ErrInvalidChainId
+		}
+		return recoverPlain(s.SponsorHash(tx), R, S, V, true)
+	default:
+		return common.Address{}, ErrTxTypeNotSupported
+	}
+}
+
 func (s londonSigner) Equal(s2 Signer) bool {
 	x, ok := s2.(londonSigner)
 	return ok && x.chainId.Cmp(s.chainId) == 0
@@ -327,6 +441,13 @@ func (s londonSigner) SignatureValues(tx *Transaction, sig []byte) (R, S, V *big
 		R, S, _ = decodeSignature(sig)
 		V = big.NewInt(int64(sig[64]))
 		return R, S, V, nil
+	case *SponsoredTx:
+		if t.ChainID.Sign() != 0 && t.ChainID.Cmp(s.chainId) != 0 {
+			return nil, nil, nil, ErrInvalidChainId
+		}
+		R, S, _ = decodeSignature(sig)
+		V = big.NewInt(int64(sig[64]))
+		return R, S, V, nil
 	default:
 		return s.eip2930Signer.SignatureValues(tx, sig)
 	}
@@ -335,7 +456,7 @@ func (s londonSigner) SignatureValues(tx *Transaction, sig []byte) (R, S, V *big
 // Hash returns the hash to be signed by the sender.
 // It does not uniquely identify the transaction.
 func (s londonSigner) Hash(tx *Transaction) common.Hash {
-	if tx.Type() != DynamicFeeTxType && tx.Type() != PriorityTxType {
+	if tx.Type() != DynamicFeeTxType && tx.Type() != PriorityTxType && tx.Type() != SponsoredTxType {
 		return s.eip2930Signer.Hash(tx)
 	}
 	return prefixedRlpHash(
@@ -359,6 +480,30 @@ func (s londonSigner) PriorityHash(tx *Transaction) common.Hash {
 	return s.Hash(tx)
 }
 
+// SponsorHash returns the hash to be signed by the sponsor of a sponsored
+// transaction. Sponsor signatures are bound to the sender from the start, so
+// unlike PriorityHash this does not change with the future fork.
+func (s londonSigner) SponsorHash(tx *Transaction) common.Hash {
+	if tx.Type() != SponsoredTxType {
+		return s.Hash(tx)
+	}
+	V, R, S := tx.RawSignatureValues()
+	return prefixedRlpHash(
+		tx.Type(),
+		[]interface{}{
+			s.chainId,
+			tx.Nonce(),
+			tx.GasTipCap(),
+			tx.GasFeeCap(),
+			tx.Gas(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			tx.AccessList(),
+			V, R, S,
+		})
+}
+
 // futureForkSigner wraps londonSigner and changes only the priority signature
 // scheme. After the future fork activates, the priority key signs over a hash
 // that includes the sender's V,R,S — binding the priority signature to that
@@ -371,8 +516,29 @@ func NewFutureForkSigner(chainId *big.Int) Signer {
 	return futureForkSigner{londonSigner{eip2930Signer{NewEIP155Signer(chainId)}}}
 }
 
+// AlternatePrioritySigner returns the signer of the other priority signature
+// scheme around the future fork: the futureForkSigner for a londonSigner and
+// vice versa. It returns nil for signers predating priority transactions.
+func AlternatePrioritySigner(s Signer) Signer {
+	switch s.(type) {
+	case londonSigner:
+		return NewFutureForkSigner(s.ChainID())
+	case futureForkSigner:
+		return NewLondonSigner(s.ChainID())
+	default:
+		return nil
+	}
+}
+
+// SenderBoundPriority reports whether the signer binds priority signatures to the
+// signature of the sender, as required from the future fork on.
+func SenderBoundPriority(s Signer) bool {
+	_, ok := s.(futureForkSigner)
+	return ok
+}
+
 func (s futureForkSigner) Sender(tx *Transaction) (common.Address, error) {
-	if tx.Type() != DynamicFeeTxType && tx.Type() != PriorityTxType {
+	if tx.Type() != DynamicFeeTxType && tx.Type() != PriorityTxType && tx.Type() != SponsoredTxType {
 		return s.londonSigner.eip2930Signer.Sender(tx)
 	}
 	V, R, S := tx.RawSignatureValues()
@@ -386,6 +552,11 @@ func (s futureForkSigner) Sender(tx *Transaction) (common.Address, error) {
 			return common.Address{}, err
 		}
 	}
+	if tx.Type() == SponsoredTxType {
+		if _, err := s.Sponsor(tx); err != nil {
+			return common.Address{}, err
+		}
+	}
 	return recoverPlain(s.Hash(tx), R, S, V, true)
 }
 
@@ -480,6 +651,14 @@ func (s eip2930Signer) PriorityHash(tx *Transaction) common.Hash {
 	return s.Hash(tx)
 }
 
+func (s eip2930Signer) Sponsor(tx *Transaction) (common.Address, error) {
+	return common.Address{}, ErrTxTypeNotSupported
+}
+
+func (s eip2930Signer) SponsorHash(tx *Transaction) common.Hash {
+	return s.Hash(tx)
+}
+
 func (s eip2930Signer) SignatureValues(tx *Transaction, sig []byte) (R, S, V *big.Int, err error) {
 	switch txdata := tx.inner.(type) {
 	case *LegacyTx:
@@ -585,6 +764,14 @@ func (s EIP155Signer) PriorityHash(tx *Transaction) common.Hash {
 	return s.Hash(tx)
 }
 
+func (s EIP155Signer) Sponsor(tx *Transaction) (common.Address, error) {
+	return common.Address{}, ErrTxTypeNotSupported
+}
+
+func (s EIP155Signer) SponsorHash(tx *Transaction) common.Hash {
+	return s.Hash(tx)
+}
+
 // SignatureValues returns signature values. This signature
 // needs to be in the [R || S || V] format where V is 0 or 1.
 func (s EIP155Signer) SignatureValues(tx *Transaction, sig []byte) (R, S, V *big.Int, err error) {
@@ -648,6 +835,14 @@ func (hs HomesteadSigner) PriorityHash(tx *Transaction) common.Hash {
 	return hs.Hash(tx)
 }
 
+func (hs HomesteadSigner) Sponsor(tx *Transaction) (common.Address, error) {
+	return common.Address{}, ErrTxTypeNotSupported
+}
+
+func (hs HomesteadSigner) SponsorHash(tx *Transaction) common.Hash {
+	return hs.Hash(tx)
+}
+
 type FrontierSigner struct{}
 
 func (fs FrontierSigner) ChainID() *big.Int {
@@ -675,6 +870,14 @@ func (fs FrontierSigner) PriorityHash(tx *Transaction) common.Hash {
 	return fs.Hash(tx)
 }
 
+func (fs FrontierSigner) Sponsor(tx *Transaction) (common.Address, error) {
+	return common.Address{}, ErrTxTypeNotSupported
+}
+
+func (fs FrontierSigner) SponsorHash(tx *Transaction) common.Hash {
+	return fs.Hash(tx)
+}
+
 // SignatureValues returns signature values. This signature
 // needs to be in the [R || S || V] format where V is 0 or 1.
 func (fs FrontierSigner) SignatureValues(tx *Transaction, sig []byte) (r, s, v *big.Int, err error) {
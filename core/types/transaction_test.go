@@ -302,6 +302,82 @@ func TestETNIP1Signer(t *testing.T) {
 	}
 }
 
+func TestSponsoredTxSigner(t *testing.T) {
+	var (
+		key, _        = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		keyAddr       = crypto.PubkeyToAddress(key.PublicKey)
+		sponsorKey, _ = crypto.HexToECDSA("f672360baf37be77cc8d6a3986781b6b01c35d4e360078c2af374055dcb2005b")
+		sponsorAddr   = crypto.PubkeyToAddress(sponsorKey.PublicKey)
+		otherKey, _   = crypto.GenerateKey()
+		signer        = NewLondonSigner(big.NewInt(1))
+		txdata        = &SponsoredTx{ChainID: big.NewInt(1), Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, To: &testAddr, Value: big.NewInt(10)}
+	)
+	tx, err := SignNewSponsoredTx(key, sponsorKey, signer, txdata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []Signer{signer, NewFutureForkSigner(big.NewInt(1))} {
+		if sender, err := Sender(s, tx); err != nil || sender != keyAddr {
+			t.Errorf("wrong sender: have %x, %v, want %x", sender, err, keyAddr)
+		}
+		if sponsor, err := Sponsor(s, tx); err != nil || sponsor != sponsorAddr {
+			t.Errorf("wrong sponsor: have %x, %v, want %x", sponsor, err, sponsorAddr)
+		}
+	}
+	if _, err := Sponsor(NewEIP2930Signer(big.NewInt(1)), tx); err != ErrTxTypeNotSupported {
+		t.Errorf("wrong pre-London sponsor error: %v", err)
+	}
+	if cost := tx.Cost(); cost.Cmp(big.NewInt(10)) != 0 {
+		t.Errorf("wrong sender cost: have %v, want 10", cost)
+	}
+	if cost := tx.SponsorCost(); cost.Cmp(big.NewInt(42000)) != 0 {
+		t.Errorf("wrong sponsor cost: have %v, want 42000", cost)
+	}
+	// The sponsor signature is bound to the sender, so re-signing the body with
+	// another key must not keep the same sponsor.
+	other, err := tx.WithSignature(signer, mustSign(t, otherKey, signer.Hash(tx)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sponsor, err := Sponsor(signer, other); err == nil && sponsor == sponsorAddr {
+		t.Errorf("sponsor signature reused for a different sender")
+	}
+	// Both signatures survive the binary and JSON encodings.
+	blob, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec Transaction
+	if err := dec.UnmarshalBinary(blob); err != nil {
+		t.Fatal(err)
+	}
+	if sponsor, err := Sponsor(signer, &dec); err != nil || sponsor != sponsorAddr {
+		t.Errorf("wrong sponsor after binary decoding: have %x, %v", sponsor, err)
+	}
+	enc, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decJSON Transaction
+	if err := json.Unmarshal(enc, &decJSON); err != nil {
+		t.Fatal(err)
+	}
+	if decJSON.Hash() != tx.Hash() {
+		t.Errorf("wrong hash after JSON decoding: have %x, want %x", decJSON.Hash(), tx.Hash())
+	}
+	if sponsor, err := Sponsor(signer, &decJSON); err != nil || sponsor != sponsorAddr {
+		t.Errorf("wrong sponsor after JSON decoding: have %x, %v", sponsor, err)
+	}
+}
+
+func mustSign(t *testing.T, key *ecdsa.PrivateKey, hash common.Hash) []byte {
+	sig, err := crypto.Sign(hash[:], key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
 func TestEIP2718TransactionEncode(t *testing.T) {
 	// RLP representation
 	{
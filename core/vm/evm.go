@@ -82,8 +82,9 @@ type BlockContext struct {
 // All fields can change between transactions.
 type TxContext struct {
 	// Message information
-	Origin   common.Address // Provides information for ORIGIN
-	GasPrice *big.Int       // Provides information for GASPRICE
+	Origin   common.Address  // Provides information for ORIGIN
+	GasPrice *big.Int        // Provides information for GASPRICE
+	Sponsor  *common.Address // Account paying for the gas of a sponsored transaction (nil otherwise)
 }
 
 // EVM is the Ethereum Virtual Machine base object and provides
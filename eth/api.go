@@ -345,7 +345,18 @@ func (api *PrivateDebugAPI) GetBadBlocks(ctx context.Context) ([]*BadBlockArgs,
 		} else {
 			blockRlp = fmt.Sprintf("0x%x", rlpBytes)
 		}
-		if blockJSON, err = ethapi.RPCMarshalBlock(block, true, true, api.eth.APIBackend.ChainConfig()); err != nil {
+		lookup := core.PriorityTransactorLookup(func() common.PriorityTransactorMap {
+			parent := api.eth.blockchain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+			if parent == nil {
+				return nil
+			}
+			statedb, err := api.eth.blockchain.StateAt(parent.Root())
+			if err != nil {
+				return nil
+			}
+			return api.eth.blockchain.GetPriorityTransactorsForState(block.Header(), statedb)
+		})
+		if blockJSON, err = ethapi.RPCMarshalBlock(block, true, true, api.eth.APIBackend.ChainConfig(), lookup); err != nil {
 			blockJSON = map[string]interface{}{"error": err.Error()}
 		}
 		results = append(results, &BadBlockArgs{
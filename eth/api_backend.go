@@ -283,6 +283,14 @@ func (b *EthAPIBackend) TxPoolContentFrom(addr common.Address) (types.Transactio
 	return b.eth.TxPool().ContentFrom(addr)
 }
 
+func (b *EthAPIBackend) ValidateTx(tx *types.Transaction) (*core.TxValidation, error) {
+	return b.eth.TxPool().Validate(tx, true), nil
+}
+
+func (b *EthAPIBackend) PriorityMigration() ([]*core.PriorityMigration, error) {
+	return b.eth.TxPool().PriorityMigration(), nil
+}
+
 func (b *EthAPIBackend) TxPool() *core.TxPool {
 	return b.eth.TxPool()
 }
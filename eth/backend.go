@@ -32,6 +32,7 @@ import (
 	"github.com/electroneum/electroneum-sc/consensus"
 	"github.com/electroneum/electroneum-sc/consensus/beacon"
 	"github.com/electroneum/electroneum-sc/consensus/clique"
+	istanbulBackend "github.com/electroneum/electroneum-sc/consensus/istanbul/backend"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/bloombits"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
@@ -169,21 +170,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		shutdownTracker:   shutdowncheck.NewShutdownTracker(chainDb),
 	}
 
-	// Set protocol Name/Version
-	// keep `var protocolName = "eth"` as is, and only update the quorum consensus specific protocol
-	// This is used to enable the eth service to return multiple devp2p subprotocols.
-	// Previously, for istanbul/64 istnbul/99 and clique (v2.6) `protocolName` would be overridden and
-	// set to the consensus subprotocol name instead of "eth", meaning the node would no longer
-	// communicate over the "eth" subprotocol, e.g. "eth" or "istanbul/99" but not eth" and "istanbul/99".
-	// With this change, support is added so that the "eth" subprotocol remains and optionally a consensus subprotocol
-	// can be added allowing the node to communicate over "eth" and an optional consensus subprotocol, e.g. "eth" and "istanbul/100"
 	if chainConfig.IBFT != nil {
-		ibftProtocol := eth.engine.Protocol()
-		// set the ibft specific consensus devp2p subprotocol, eth subprotocol remains set to protocolName as in upstream geth.
-		ibftConsensusProtocolName = ibftProtocol.Name
-		ibftConsensusProtocolVersions = ibftProtocol.Versions
-		ibftConsensusProtocolLengths = ibftProtocol.Lengths
-
 		// force to set the istanbul etherbase to node key address
 		eth.etherbase = crypto.PubkeyToAddress(stack.GetNodeKey().PublicKey)
 	}
@@ -194,7 +181,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		dbVer = fmt.Sprintf("%d", *bcVersion)
 	}
 
-	log.Info("Initialising Electroneum Protocol", "name", ibftConsensusProtocolName, "versions", ibftConsensusProtocolVersions, "network", config.NetworkId, "dbversion", dbVer)
+	log.Info("Initialising Electroneum Protocol", "network", config.NetworkId, "dbversion", dbVer)
 
 	if !config.SkipBcVersionCheck {
 		if bcVersion != nil && *bcVersion > core.BlockChainVersion {
@@ -226,6 +213,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Let the Istanbul engine index the validator activity of new chain heads
+	if ibft, ok := eth.engine.(*istanbulBackend.Backend); ok {
+		ibft.TrackActivity(eth.blockchain)
+	}
 	// Rewind the chain in case of an incompatible config upgrade.
 	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
 		log.Warn("Rewinding chain to upgrade configuration", "err", compat)
@@ -237,6 +228,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.PriorityJournal != "" {
+		config.TxPool.PriorityJournal = stack.ResolvePath(config.TxPool.PriorityJournal)
+	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain)
 
 	// Permit the downloader to use the trie cache allowance during fast sync
@@ -564,14 +558,6 @@ func (s *Ethereum) Protocols() []p2p.Protocol {
 		protos = append(protos, snap.MakeProtocols((*snapHandler)(s.handler), s.snapDialCandidates)...)
 	}
 
-	// /Quorum
-	// add additional quorum consensus protocol if set and if not set to "eth", e.g. istanbul
-	if ibftConsensusProtocolName != "" && ibftConsensusProtocolName != eth.ProtocolName {
-		quorumProtos := s.ibftConsensusProtocols()
-		protos = append(protos, quorumProtos...)
-	}
-	// /end Quorum
-
 	return protos
 }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
 	"github.com/electroneum/electroneum-sc/consensus/istanbul"
 	istanbulBackend "github.com/electroneum/electroneum-sc/consensus/istanbul/backend"
+	"github.com/electroneum/electroneum-sc/consensus/istanbul/network"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/eth/downloader"
 	"github.com/electroneum/electroneum-sc/eth/gasprice"
@@ -89,6 +90,8 @@ var Defaults = Config{
 		GasCeil:  30000000,
 		GasPrice: big.NewInt(params.GWei),
 		Recommit: 3 * time.Second,
+
+		PriorityQuota: core.DefaultPriorityQuotaConfig,
 	},
 	TxPool:           core.DefaultTxPoolConfig,
 	RPCGasCap:        50000000,
@@ -99,7 +102,8 @@ var Defaults = Config{
 	RangeLimit:       0, // disabled by default, matching upstream go-ethereum
 
 	// Quorum
-	Istanbul: *istanbul.DefaultConfig, // Quorum
+	Istanbul:        *istanbul.DefaultConfig, // Quorum
+	IstanbulNetwork: network.DefaultConfig,
 }
 
 func init() {
@@ -144,6 +148,7 @@ type Config struct {
 	NoPrefetch bool // Whether to disable prefetching and only load state on demand
 
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
+	TraceIndex    bool   `toml:",omitempty"` // Whether to index the call traces of newly imported blocks.
 
 	// RequiredBlocks is a set of block number -> hash mappings which must be in the
 	// canonical chain of all remote peers. Setting the option makes geth verify the
@@ -194,7 +199,8 @@ type Config struct {
 	EnablePreimageRecording bool
 
 	// Istanbul options
-	Istanbul istanbul.Config
+	Istanbul        istanbul.Config
+	IstanbulNetwork network.Config
 
 	// Miscellaneous options
 	DocRoot string `toml:"-"`
@@ -247,6 +253,7 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 			MaxRequestTimeoutSeconds: chainConfig.IBFT.MaxRequestTimeoutSeconds,
 			AllowedFutureBlockTime:   chainConfig.IBFT.AllowedFutureBlockTime,
 			Transitions:              chainConfig.Transitions,
+			RoundJournal:             config.Istanbul.RoundJournal,
 		}, stack.GetNodeKey(), db)
 	} else if chainConfig.Clique != nil {
 		engine = clique.New(chainConfig.Clique, db)
@@ -18,7 +18,6 @@ package eth
 
 import (
 	"errors"
-	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -30,11 +29,9 @@ import (
 	"github.com/electroneum/electroneum-sc/consensus/beacon"
 	"github.com/electroneum/electroneum-sc/consensus/clique"
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
-	"github.com/electroneum/electroneum-sc/consensus/istanbul"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/forkid"
 	"github.com/electroneum/electroneum-sc/core/types"
-	"github.com/electroneum/electroneum-sc/crypto"
 	"github.com/electroneum/electroneum-sc/eth/downloader"
 	"github.com/electroneum/electroneum-sc/eth/fetcher"
 	"github.com/electroneum/electroneum-sc/eth/protocols/eth"
@@ -43,20 +40,17 @@ import (
 	"github.com/electroneum/electroneum-sc/event"
 	"github.com/electroneum/electroneum-sc/log"
 	"github.com/electroneum/electroneum-sc/p2p"
-	"github.com/electroneum/electroneum-sc/p2p/enode"
 	"github.com/electroneum/electroneum-sc/params"
 )
 
 const (
 	// txChanSize is the size of channel listening to NewTxsEvent.
 	// The number is referenced from the size of tx pool.
-	txChanSize         = 4096
-	protocolMaxMsgSize = 10 * 1024 * 1024 // Maximum cap on the size of a protocol message
+	txChanSize = 4096
 )
 
 var (
 	syncChallengeTimeout = 15 * time.Second // Time allowance for a node to reply to the sync progress challenge
-	errMsgTooLarge       = errors.New("message too long")
 )
 
 // txPool defines the methods needed from a transaction pool implementation to
@@ -346,9 +340,6 @@ func (h *handler) runEthPeer(peer *eth.Peer, handler eth.Handler) error {
 	forkID := forkid.NewID(h.chain.Config(), h.chain.Genesis().Hash(), h.chain.CurrentHeader().Number.Uint64())
 	if err := peer.Handshake(h.networkID, td, hash, genesis.Hash(), forkID, h.forkFilter); err != nil {
 		peer.Log().Debug("Ethereum handshake failed", "err", err)
-
-		// When the Handshake() returns an error, the Run method corresponding to `eth` protocol returns with the error, causing the peer to drop, signal subprotocol as well to exit the `Run` method
-		peer.EthPeerDisconnected <- struct{}{}
 		return err
 	}
 	reject := false // reserved peer slots
@@ -373,9 +364,6 @@ func (h *handler) runEthPeer(peer *eth.Peer, handler eth.Handler) error {
 	// Register the peer locally
 	if err := h.peers.registerPeer(peer, snap); err != nil {
 		peer.Log().Error("Ethereum peer registration failed", "err", err)
-
-		// When the Register() returns an error, the Run method corresponding to `eth` protocol returns with the error, causing the peer to drop, signal subprotocol as well to exit the `Run` method
-		peer.EthPeerDisconnected <- struct{}{}
 		return err
 	}
 	defer h.unregisterPeer(peer.ID())
@@ -490,8 +478,6 @@ func (h *handler) runEthPeer(peer *eth.Peer, handler eth.Handler) error {
 		}(number, hash)
 	}
 
-	p.EthPeerRegistered <- struct{}{}
-
 	// Handle incoming messages until the connection is torn down
 	return handler(peer)
 }
@@ -747,124 +733,8 @@ func (h *handler) getConsensusAlgorithm() string {
 	return consensusAlgo
 }
 
+// FindPeers implements consensus.Broadcaster. Consensus messages are exchanged by
+// the consensus engine's own network service, so no eth peer is ever returned.
 func (h *handler) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
-	m := make(map[common.Address]consensus.Peer)
-	h.peers.lock.RLock()
-	defer h.peers.lock.RUnlock()
-	for _, p := range h.peers.peers {
-		pubKey := p.Node().Pubkey()
-		addr := crypto.PubkeyToAddress(*pubKey)
-		if targets[addr] {
-			m[addr] = p
-		}
-	}
-	return m
-}
-
-// makeIbftConsensusProtocol is similar to eth/handler.go -> makeProtocol. Called from eth/handler.go -> Protocols.
-// returns the supported subprotocol to the p2p server.
-// The Run method starts the protocol and is called by the p2p server. The ibft consensus subprotocol,
-// leverages the peer created and managed by the "eth" subprotocol.
-// The ibft consensus protocol requires that the "eth" protocol is running as well.
-func (h *handler) makeIbftConsensusProtocol(ProtoName string, version uint, length uint64) p2p.Protocol {
-	return p2p.Protocol{
-		Name:    ProtoName,
-		Version: version,
-		Length:  length,
-		// no new peer created, uses the "eth" peer, so no peer management needed.
-		Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
-			/*
-			* 1. wait for the eth protocol to create and register an eth peer.
-			* 2. get the associate eth peer that was registered by he "eth" protocol.
-			* 3. add the rw protocol for the ibft subprotocol to the eth peer.
-			* 4. start listening for incoming messages.
-			* 5. the incoming message will be sent on the ibft specific subprotocol, e.g. "istanbul/100".
-			* 6. send messages to the consensus engine handler.
-			* 7. messages to other to other peers listening to the subprotocol can be sent using the
-			*    (eth)peer.ConsensusSend() which will write to the protoRW.
-			 */
-			// wait for the "eth" protocol to create and register the peer (added to peerset)
-			select {
-			case <-p.EthPeerRegistered:
-				// the ethpeer should be registered, try to retrieve it and start the consensus handler.
-				p2pPeerId := fmt.Sprintf("%x", p.ID().Bytes()[:8])
-				ethPeer := h.peers.peer(p2pPeerId)
-				if ethPeer == nil {
-					p2pPeerId = fmt.Sprintf("%x", p.ID().Bytes()) // TODO:BBO
-					ethPeer = h.peers.peer(p2pPeerId)
-					log.Warn("full p2p peer", "id", p2pPeerId, "etnPeer", ethPeer)
-				}
-				if ethPeer != nil {
-					p.Log().Debug("consensus subprotocol retrieved eth peer from peerset", "etnPeer.id", p2pPeerId, "ProtoName", ProtoName)
-					// add the rw protocol for the ibft subprotocol to the eth peer.
-					ethPeer.AddConsensusProtoRW(rw)
-					return h.handleConsensusLoop(p, rw)
-				}
-				p.Log().Error("consensus subprotocol retrieved nil eth peer from peerset", "etnPeer.id", p2pPeerId)
-				return errEthPeerNil
-			case <-p.EthPeerDisconnected:
-				return errEthPeerNotRegistered
-			}
-		},
-		NodeInfo: func() interface{} {
-			return h.NodeInfo()
-		},
-		PeerInfo: func(id enode.ID) interface{} {
-			if p := h.peers.peer(fmt.Sprintf("%x", id[:8])); p != nil {
-				return p.Info()
-			}
-			if p := h.peers.peer(fmt.Sprintf("%x", id)); p != nil { // TODO:BBO
-				return p.Info()
-			}
-			return nil
-		},
-	}
-}
-
-func (h *handler) handleConsensusLoop(p *p2p.Peer, protoRW p2p.MsgReadWriter) error {
-	// Handle incoming messages until the connection is torn down
-	for {
-		if err := h.handleConsensus(p, protoRW); err != nil {
-			if errors.Is(err, istanbul.ErrStoppedEngine) && h.downloader.Synchronising() {
-				p.Log().Debug("Ignoring `stopped engine` consensus error due to active sync.")
-				continue
-			}
-			p.Log().Debug("Ethereum ibft message handling failed", "err", err)
-			return err
-		}
-	}
-}
-
-// This is a no-op because the eth handleMsg main loop handle ibf message as well.
-func (h *handler) handleConsensus(p *p2p.Peer, protoRW p2p.MsgReadWriter) error {
-	// Read the next message from the remote peer (in protoRW), and ensure it's fully consumed
-	msg, err := protoRW.ReadMsg()
-	if err != nil {
-		return err
-	}
-	if msg.Size > protocolMaxMsgSize {
-		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, protocolMaxMsgSize)
-	}
-	defer msg.Discard()
-
-	// See if the consensus engine protocol can handle this message, e.g. istanbul will check for message is
-	// istanbulMsg = 0x11, and NewBlockMsg = 0x07.
-	handled, err := h.handleConsensusMsg(p, msg)
-	if handled {
-		p.Log().Debug("consensus message was handled by consensus engine", "handled", handled,
-			"ibftConsensusProtocolName", ibftConsensusProtocolName, "err", err)
-		return err
-	}
-
-	return nil
-}
-
-func (h *handler) handleConsensusMsg(p *p2p.Peer, msg p2p.Msg) (bool, error) {
-	if handler, ok := h.engine.(consensus.Handler); ok {
-		pubKey := p.Node().Pubkey()
-		addr := crypto.PubkeyToAddress(*pubKey)
-		handled, err := handler.HandleMsg(addr, msg)
-		return handled, err
-	}
-	return false, nil
+	return make(map[common.Address]consensus.Peer)
 }
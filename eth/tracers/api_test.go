@@ -33,6 +33,7 @@ import (
 	"github.com/electroneum/electroneum-sc/common/hexutil"
 	"github.com/electroneum/electroneum-sc/consensus"
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
 	"github.com/electroneum/electroneum-sc/core/state"
@@ -41,6 +42,7 @@ import (
 	"github.com/electroneum/electroneum-sc/crypto"
 	"github.com/electroneum/electroneum-sc/eth/tracers/logger"
 	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/event"
 	"github.com/electroneum/electroneum-sc/internal/ethapi"
 	"github.com/electroneum/electroneum-sc/params"
 	"github.com/electroneum/electroneum-sc/rpc"
@@ -66,6 +68,9 @@ func newTestBackend(t *testing.T, n int, gspec *core.Genesis, generator func(i i
 		chaindb:     rawdb.NewMemoryDatabase(),
 	}
 	// Generate blocks for testing
+	if gspec.Config != nil {
+		backend.chainConfig = gspec.Config
+	}
 	gspec.Config = backend.chainConfig
 	var (
 		gendb   = rawdb.NewMemoryDatabase()
@@ -139,6 +144,10 @@ func (b *testBackend) ChainDb() ethdb.Database {
 	return b.chaindb
 }
 
+func (b *testBackend) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return b.chain.SubscribeChainHeadEvent(ch)
+}
+
 func (b *testBackend) StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, checkLive bool, preferDisk bool) (*state.StateDB, error) {
 	statedb, err := b.chain.StateAt(block.Root())
 	if err != nil {
@@ -424,6 +433,165 @@ func TestTraceBlock(t *testing.T) {
 	}
 }
 
+// Tests that blocks with gas price waived priority transactions are traced with
+// the priority transactors of the block, as they were processed.
+func TestTraceBlockPriorityWaiver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accounts       = newAccounts(2)
+		priorityKey, _ = crypto.GenerateKey()
+		pubkey         = common.BytesToPublicKey(crypto.FromECDSAPub(&priorityKey.PublicKey))
+		contract       = common.HexToAddress("0x9999999999999999999999999999999999999999")
+	)
+	code, err := prioritytransactors.StubCode(common.PriorityTransactorMap{
+		pubkey: {IsGasPriceWaiver: true, EntityName: "Waiver Entity"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create priority contract code: %v", err)
+	}
+	config := *params.TestChainConfig
+	config.PriorityTransactorsContractAddress = contract
+
+	genesis := &core.Genesis{Config: &config, Alloc: core.GenesisAlloc{
+		accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		contract:         {Code: code, Balance: new(big.Int)},
+	}}
+	var target common.Hash
+	backend := newTestBackend(t, 1, genesis, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignNewPriorityTx(accounts[0].key, priorityKey, types.LatestSigner(&config), &types.PriorityTx{
+			ChainID:   config.ChainID,
+			GasTipCap: new(big.Int),
+			GasFeeCap: new(big.Int),
+			Gas:       params.TxGas,
+			To:        &accounts[1].addr,
+			Value:     big.NewInt(1000),
+		})
+		b.AddTx(tx)
+		target = tx.Hash()
+	})
+	api := NewAPI(backend)
+
+	result, err := api.TraceBlockByNumber(context.Background(), rpc.BlockNumber(1), nil)
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	want := `[{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}}]`
+	if have, _ := json.Marshal(result); string(have) != want {
+		t.Errorf("block result mismatch, have %s, want %s", have, want)
+	}
+	tx, err := api.TraceTransaction(context.Background(), target, nil)
+	if err != nil {
+		t.Fatalf("failed to trace transaction: %v", err)
+	}
+	if have, _ := json.Marshal(tx); string(have) != `{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}` {
+		t.Errorf("transaction result mismatch, have %s", have)
+	}
+	roots, err := api.IntermediateRoots(context.Background(), backend.chain.GetBlockByNumber(1).Hash(), nil)
+	if err != nil || len(roots) != 1 {
+		t.Fatalf("intermediate roots mismatch: have %v, %v", roots, err)
+	}
+}
+
+func TestTraceIndexed(t *testing.T) {
+	t.Parallel()
+
+	// Initialize test accounts
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{Alloc: core.GenesisAlloc{
+		accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		accounts[1].addr: {Balance: big.NewInt(params.Ether)},
+	}}
+	var target common.Hash
+	signer := types.HomesteadSigner{}
+	backend := newTestBackend(t, 2, genesis, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+		b.AddTx(tx)
+		target = tx.Hash()
+	})
+	api := NewAPI(backend)
+
+	// Store a marker trace for the head block, which must be served as is
+	head := backend.chain.CurrentBlock()
+	rawdb.WriteBlockTraces(backend.chaindb, head.Hash(), head.NumberU64(), []byte(`[{"result":{"indexed":true}}]`))
+
+	tracer := indexedTracer
+	for i, config := range []*TraceConfig{
+		{Tracer: &tracer},
+		{Tracer: &tracer, TracerConfig: json.RawMessage(`{}`)},
+	} {
+		result, err := api.TraceBlockByNumber(context.Background(), rpc.LatestBlockNumber, config)
+		if err != nil {
+			t.Fatalf("test %d: failed to trace block: %v", i, err)
+		}
+		if have, _ := json.Marshal(result); string(have) != `[{"result":{"indexed":true}}]` {
+			t.Errorf("test %d: block result mismatch, have %s", i, have)
+		}
+		tx, err := api.TraceTransaction(context.Background(), target, config)
+		if err != nil {
+			t.Fatalf("test %d: failed to trace transaction: %v", i, err)
+		}
+		if have, _ := json.Marshal(tx); string(have) != `{"indexed":true}` {
+			t.Errorf("test %d: transaction result mismatch, have %s", i, have)
+		}
+	}
+	// Other tracers or configs must re-execute the block
+	result, err := api.TraceBlockByNumber(context.Background(), rpc.LatestBlockNumber, nil)
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	if have, _ := json.Marshal(result); string(have) != `[{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}}]` {
+		t.Errorf("re-executed result mismatch, have %s", have)
+	}
+	// The call tracer isn't linked in here, so re-executing with it must fail
+	result, err = api.TraceBlockByNumber(context.Background(), rpc.LatestBlockNumber, &TraceConfig{Tracer: &tracer, TracerConfig: json.RawMessage(`{"onlyTopCall":true}`)})
+	if err != nil || result[0].Error == "" {
+		t.Errorf("expected re-execution with a custom config, have %v", err)
+	}
+	// Traces not matching the block's transactions must be ignored
+	rawdb.WriteBlockTraces(backend.chaindb, head.Hash(), head.NumberU64(), []byte(`[]`))
+	result, err = api.TraceBlockByNumber(context.Background(), rpc.LatestBlockNumber, &TraceConfig{Tracer: &tracer})
+	if err != nil || result[0].Error == "" {
+		t.Errorf("expected mismatched traces to be ignored, have %v", err)
+	}
+}
+
+// Tests that transactions failing to be traced don't hold the indexer back, their
+// errors being stored and served in place of the traces.
+func TestIndexerFailedTraces(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{Alloc: core.GenesisAlloc{
+		accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+	}}
+	var hashes []common.Hash
+	signer := types.HomesteadSigner{}
+	backend := newTestBackend(t, 3, genesis, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+		b.AddTx(tx)
+		hashes = append(hashes, tx.Hash())
+	})
+	// The call tracer isn't linked in here, so every transaction fails to be traced
+	NewIndexer(backend).index(backend.chain.CurrentBlock())
+
+	api := NewAPI(backend)
+	tracer := indexedTracer
+	for i, hash := range hashes {
+		block := backend.chain.GetBlockByNumber(uint64(i + 1))
+		if !rawdb.HasBlockTraces(backend.chaindb, block.Hash(), block.NumberU64()) {
+			t.Fatalf("block %d: traces not indexed", i+1)
+		}
+		results := api.indexedBlockTraces(block, &TraceConfig{Tracer: &tracer})
+		if len(results) != 1 || results[0].Error == "" || results[0].Result != nil {
+			t.Fatalf("block %d: indexed results mismatch: %v", i+1, results)
+		}
+		if _, err := api.TraceTransaction(context.Background(), hash, &TraceConfig{Tracer: &tracer}); err == nil || err.Error() != results[0].Error {
+			t.Errorf("block %d: transaction error mismatch: have %v, want %s", i+1, err, results[0].Error)
+		}
+	}
+}
+
 func TestTracingWithOverrides(t *testing.T) {
 	t.Parallel()
 	// Initialize test accounts
@@ -0,0 +1,245 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/event"
+	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+const (
+	// indexedTracer is the tracer whose results are stored by the trace indexer.
+	indexedTracer = "callTracer"
+
+	// maxIndexCatchup is the maximum number of ancestors of a new head the
+	// indexer traces when it finds them missing from the index, e.g. after
+	// a restart or a reorg.
+	maxIndexCatchup = 128
+
+	// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
+	chainHeadChanSize = 10
+)
+
+// IndexerBackend is the interface required by the trace indexer to follow the
+// chain head on top of the tracing backend.
+type IndexerBackend interface {
+	Backend
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// Indexer traces every newly imported block with the call tracer and stores
+// the results in the database, so the tracing APIs can serve them without
+// re-executing the block.
+type Indexer struct {
+	api     *API
+	backend IndexerBackend
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewIndexer creates a trace indexer on top of the given backend.
+func NewIndexer(backend IndexerBackend) *Indexer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Indexer{
+		api:     NewAPI(backend),
+		backend: backend,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start implements node.Lifecycle, starting the indexing loop.
+func (idx *Indexer) Start() error {
+	idx.wg.Add(1)
+	go idx.loop()
+	log.Info("Started block trace indexer")
+	return nil
+}
+
+// Stop implements node.Lifecycle, terminating the indexing loop.
+func (idx *Indexer) Stop() error {
+	idx.cancel()
+	idx.wg.Wait()
+	log.Info("Stopped block trace indexer")
+	return nil
+}
+
+// loop indexes the traces of every new chain head until stopped.
+func (idx *Indexer) loop() {
+	defer idx.wg.Done()
+
+	headCh := make(chan core.ChainHeadEvent, chainHeadChanSize)
+	sub := idx.backend.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-headCh:
+			idx.index(head.Block)
+		case <-sub.Err():
+			return
+		case <-idx.ctx.Done():
+			return
+		}
+	}
+}
+
+// index stores the traces of the given block, along with any of its recent
+// ancestors which are not indexed yet. Blocks failing to be traced are skipped,
+// so they don't hold the others back.
+func (idx *Indexer) index(head *types.Block) {
+	var (
+		db      = idx.backend.ChainDb()
+		pending []*types.Block
+	)
+	for block := head; block != nil && block.NumberU64() > 0 && len(pending) < maxIndexCatchup; {
+		if rawdb.HasBlockTraces(db, block.Hash(), block.NumberU64()) {
+			break
+		}
+		pending = append(pending, block)
+
+		parent, err := idx.backend.BlockByHash(idx.ctx, block.ParentHash())
+		if err != nil {
+			break
+		}
+		block = parent
+	}
+	for i := len(pending) - 1; i >= 0; i-- {
+		if idx.ctx.Err() != nil {
+			return
+		}
+		if err := idx.indexBlock(pending[i]); err != nil {
+			log.Warn("Failed to index block traces", "number", pending[i].NumberU64(), "hash", pending[i].Hash(), "err", err)
+		}
+	}
+}
+
+// indexBlock traces a single block and stores its call traces. Transactions
+// failing to be traced are stored with their error, which re-executing them
+// would yield again.
+func (idx *Indexer) indexBlock(block *types.Block) error {
+	tracer := indexedTracer
+	results, err := idx.api.traceBlock(idx.ctx, block, &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return err
+	}
+	blob, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	rawdb.WriteBlockTraces(idx.backend.ChainDb(), block.Hash(), block.NumberU64(), blob)
+	return nil
+}
+
+// isIndexed reports whether the results of the given trace config are the ones
+// stored by the trace indexer.
+func isIndexed(config *TraceConfig) bool {
+	if config == nil || config.Tracer == nil || *config.Tracer != indexedTracer {
+		return false
+	}
+	cfg := bytes.TrimSpace(config.TracerConfig)
+	return len(cfg) == 0 || bytes.Equal(cfg, []byte("null")) || bytes.Equal(cfg, []byte("{}"))
+}
+
+// indexedBlockTraces returns the stored traces of the given block if the trace
+// config matches the indexed tracer, or nil if they are not available.
+func (api *API) indexedBlockTraces(block *types.Block, config *TraceConfig) []*txTraceResult {
+	if !isIndexed(config) {
+		return nil
+	}
+	blob := rawdb.ReadBlockTraces(api.backend.ChainDb(), block.Hash(), block.NumberU64())
+	if len(blob) == 0 {
+		return nil
+	}
+	var traces []struct {
+		Result json.RawMessage `json:"result"`
+		Error  string          `json:"error"`
+	}
+	if err := json.Unmarshal(blob, &traces); err != nil {
+		log.Error("Invalid block traces in database", "number", block.NumberU64(), "hash", block.Hash(), "err", err)
+		return nil
+	}
+	if len(traces) != len(block.Transactions()) {
+		log.Error("Block traces mismatch transactions", "number", block.NumberU64(), "hash", block.Hash(), "traces", len(traces), "txs", len(block.Transactions()))
+		return nil
+	}
+	results := make([]*txTraceResult, len(traces))
+	for i, trace := range traces {
+		if len(trace.Result) == 0 && trace.Error == "" {
+			log.Error("Invalid block traces in database", "number", block.NumberU64(), "hash", block.Hash(), "index", i)
+			return nil
+		}
+		results[i] = &txTraceResult{Error: trace.Error}
+		if trace.Error == "" {
+			results[i].Result = trace.Result
+		}
+	}
+	return results
+}
+
+// indexedChain streams the stored traces of the blocks in (start, end] if all
+// of them are indexed, or returns nil if the chain needs to be re-executed.
+func (api *API) indexedChain(ctx context.Context, start, end *types.Block, config *TraceConfig) (*rpc.Subscription, error) {
+	if !isIndexed(config) {
+		return nil, nil
+	}
+	var results []*blockTraceResult
+	for number := start.NumberU64() + 1; number <= end.NumberU64(); number++ {
+		block, err := api.blockByNumber(ctx, rpc.BlockNumber(number))
+		if err != nil {
+			return nil, nil
+		}
+		traces := api.indexedBlockTraces(block, config)
+		if traces == nil {
+			return nil, nil
+		}
+		results = append(results, &blockTraceResult{
+			Block:  hexutil.Uint64(number),
+			Hash:   block.Hash(),
+			Traces: traces,
+		})
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+
+	go func() {
+		for _, result := range results {
+			// Only blocks with transactions are streamed, save for the last one
+			if len(result.Traces) > 0 || uint64(result.Block) == end.NumberU64() {
+				if err := notifier.Notify(sub.ID, result); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return sub, nil
+}
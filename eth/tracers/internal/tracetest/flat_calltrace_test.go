@@ -0,0 +1,170 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracetest
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/eth/tracers"
+	"github.com/electroneum/electroneum-sc/rlp"
+	"github.com/electroneum/electroneum-sc/tests"
+)
+
+// flatCallTrace is the subset of a flatCallTracer trace checked by the tests.
+type flatCallTrace struct {
+	Action struct {
+		Address *common.Address `json:"address"`
+		From    *common.Address `json:"from"`
+	} `json:"action"`
+	Error               string       `json:"error"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash"`
+	TransactionPosition uint64       `json:"transactionPosition"`
+	Type                string       `json:"type"`
+}
+
+// flattenCallTrace converts a nested callTracer result into the flat traces the
+// flatCallTracer is expected to produce, depth first.
+func flattenCallTrace(call *callTrace, traceAddress []int) []flatCallTrace {
+	trace := flatCallTrace{
+		Subtraces:    len(call.Calls),
+		TraceAddress: traceAddress,
+	}
+	from := call.From
+	switch call.Type {
+	case "CREATE", "CREATE2":
+		trace.Type = "create"
+		trace.Action.From = &from
+	case "SELFDESTRUCT":
+		trace.Type = "suicide"
+		trace.Action.Address = &from
+	default:
+		trace.Type = "call"
+		trace.Action.From = &from
+	}
+	trace.Error = call.Error
+	traces := []flatCallTrace{trace}
+	for i := range call.Calls {
+		childAddress := append(append([]int{}, traceAddress...), i)
+		traces = append(traces, flattenCallTrace(&call.Calls[i], childAddress)...)
+	}
+	return traces
+}
+
+// Iterates over all the callTracer datasets and checks that the flatCallTracer
+// reports the same call frames, flattened in the Parity trace format.
+func TestFlatCallTracerNative(t *testing.T) {
+	dirPath := "call_tracer"
+	files, err := os.ReadDir(filepath.Join("testdata", dirPath))
+	if err != nil {
+		t.Fatalf("failed to retrieve tracer test suite: %v", err)
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		t.Run(camel(strings.TrimSuffix(file.Name(), ".json")), func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				test = new(callTracerTest)
+				tx   = new(types.Transaction)
+			)
+			if blob, err := os.ReadFile(filepath.Join("testdata", dirPath, file.Name())); err != nil {
+				t.Fatalf("failed to read testcase: %v", err)
+			} else if err := json.Unmarshal(blob, test); err != nil {
+				t.Fatalf("failed to parse testcase: %v", err)
+			}
+			if err := rlp.DecodeBytes(common.FromHex(test.Input), tx); err != nil {
+				t.Fatalf("failed to parse testcase input: %v", err)
+			}
+			var (
+				signer    = types.MakeSigner(test.Genesis.Config, new(big.Int).SetUint64(uint64(test.Context.Number)))
+				origin, _ = signer.Sender(tx)
+				txContext = vm.TxContext{
+					Origin:   origin,
+					GasPrice: tx.GasPrice(),
+				}
+				context = vm.BlockContext{
+					CanTransfer: core.CanTransfer,
+					Transfer:    core.Transfer,
+					Coinbase:    test.Context.Miner,
+					BlockNumber: new(big.Int).SetUint64(uint64(test.Context.Number)),
+					Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+					Difficulty:  (*big.Int)(test.Context.Difficulty),
+					GasLimit:    uint64(test.Context.GasLimit),
+				}
+				_, statedb = tests.MakePreState(rawdb.NewMemoryDatabase(), test.Genesis.Alloc, false)
+			)
+			tracer, err := tracers.New("flatCallTracer", &tracers.Context{TxHash: tx.Hash(), TxIndex: 1}, nil)
+			if err != nil {
+				t.Fatalf("failed to create flat call tracer: %v", err)
+			}
+			evm := vm.NewEVM(context, txContext, statedb, test.Genesis.Config, vm.Config{Debug: true, Tracer: tracer})
+			msg, err := tx.AsMessage(signer, nil)
+			if err != nil {
+				t.Fatalf("failed to prepare transaction for tracing: %v", err)
+			}
+			st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(tx.Gas()))
+			if _, err = st.TransitionDb(); err != nil {
+				t.Fatalf("failed to execute transaction: %v", err)
+			}
+			res, err := tracer.GetResult()
+			if err != nil {
+				t.Fatalf("failed to retrieve trace result: %v", err)
+			}
+			var have []flatCallTrace
+			if err := json.Unmarshal(res, &have); err != nil {
+				t.Fatalf("failed to unmarshal trace result: %v", err)
+			}
+			want := flattenCallTrace(test.Result, []int{})
+			if len(have) != len(want) {
+				t.Fatalf("trace count mismatch: have %d, want %d", len(have), len(want))
+			}
+			for i := range have {
+				if have[i].TransactionHash == nil || *have[i].TransactionHash != tx.Hash() || have[i].TransactionPosition != 1 {
+					t.Errorf("trace %d: transaction mismatch: have %v/%d, want %v/1", i, have[i].TransactionHash, have[i].TransactionPosition, tx.Hash())
+				}
+				have[i].TransactionHash, have[i].TransactionPosition = nil, 0
+				// Parity reports errors with its own wording, check reverts only
+				if want[i].Error == vm.ErrExecutionReverted.Error() && have[i].Error != "Reverted" {
+					t.Errorf("trace %d: error mismatch: have %q, want %q", i, have[i].Error, "Reverted")
+				}
+				if have[i].Error != "" {
+					have[i].Error, want[i].Error = "-", "-"
+				}
+				if !reflect.DeepEqual(have[i], want[i]) {
+					haveJSON, _ := json.Marshal(have[i])
+					wantJSON, _ := json.Marshal(want[i])
+					t.Errorf("trace %d mismatch: \nhave %s\nwant %s", i, haveJSON, wantJSON)
+				}
+			}
+		})
+	}
+}
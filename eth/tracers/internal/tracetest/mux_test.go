@@ -0,0 +1,115 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/eth/tracers"
+	"github.com/electroneum/electroneum-sc/rlp"
+	"github.com/electroneum/electroneum-sc/tests"
+)
+
+// runTracer executes the transaction of the given callTracer test with the
+// named tracer and returns its result.
+func runTracer(t *testing.T, test *callTracerTest, name string, cfg json.RawMessage) json.RawMessage {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(common.FromHex(test.Input), tx); err != nil {
+		t.Fatalf("failed to parse testcase input: %v", err)
+	}
+	var (
+		signer    = types.MakeSigner(test.Genesis.Config, new(big.Int).SetUint64(uint64(test.Context.Number)))
+		origin, _ = signer.Sender(tx)
+		txContext = vm.TxContext{
+			Origin:   origin,
+			GasPrice: tx.GasPrice(),
+		}
+		context = vm.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			Coinbase:    test.Context.Miner,
+			BlockNumber: new(big.Int).SetUint64(uint64(test.Context.Number)),
+			Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+			Difficulty:  (*big.Int)(test.Context.Difficulty),
+			GasLimit:    uint64(test.Context.GasLimit),
+		}
+		_, statedb = tests.MakePreState(rawdb.NewMemoryDatabase(), test.Genesis.Alloc, false)
+	)
+	tracer, err := tracers.New(name, new(tracers.Context), cfg)
+	if err != nil {
+		t.Fatalf("failed to create %s: %v", name, err)
+	}
+	evm := vm.NewEVM(context, txContext, statedb, test.Genesis.Config, vm.Config{Debug: true, Tracer: tracer})
+	msg, err := tx.AsMessage(signer, nil)
+	if err != nil {
+		t.Fatalf("failed to prepare transaction for tracing: %v", err)
+	}
+	st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(tx.Gas()))
+	if _, err = st.TransitionDb(); err != nil {
+		t.Fatalf("failed to execute transaction: %v", err)
+	}
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to retrieve trace result: %v", err)
+	}
+	return res
+}
+
+// Tests that the muxTracer reports the same results as running each of its
+// tracers separately.
+func TestMuxTracer(t *testing.T) {
+	test := new(callTracerTest)
+	if blob, err := os.ReadFile(filepath.Join("testdata", "call_tracer", "deep_calls.json")); err != nil {
+		t.Fatalf("failed to read testcase: %v", err)
+	} else if err := json.Unmarshal(blob, test); err != nil {
+		t.Fatalf("failed to parse testcase: %v", err)
+	}
+	names := []string{"callTracer", "4byteTracer", "prestateTracer"}
+
+	res := runTracer(t, test, "muxTracer", json.RawMessage(`{"callTracer": null, "4byteTracer": {}, "prestateTracer": null}`))
+	var have map[string]json.RawMessage
+	if err := json.Unmarshal(res, &have); err != nil {
+		t.Fatalf("failed to unmarshal mux result: %v", err)
+	}
+	if len(have) != len(names) {
+		t.Fatalf("result count mismatch: have %d, want %d", len(have), len(names))
+	}
+	for _, name := range names {
+		want := runTracer(t, test, name, nil)
+		if !bytes.Equal(have[name], want) {
+			t.Errorf("%s result mismatch: \nhave %s\nwant %s", name, have[name], want)
+		}
+	}
+	// An unknown tracer fails the whole mux
+	if _, err := tracers.New("muxTracer", new(tracers.Context), json.RawMessage(`{"unknownTracer": null}`)); err == nil {
+		t.Errorf("expected error for unknown tracer")
+	}
+	// Config errors of a known tracer are reported as such
+	if _, err := tracers.New("muxTracer", new(tracers.Context), json.RawMessage(`{}`)); err == nil || err.Error() != "no tracers configured" {
+		t.Errorf("config error mismatch: have %v", err)
+	}
+}
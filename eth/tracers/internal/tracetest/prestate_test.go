@@ -0,0 +1,348 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracetest
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/eth/tracers"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/tests"
+)
+
+// prestateAccount is an account of a prestateTracer result.
+type prestateAccount struct {
+	Balance string                      `json:"balance"`
+	Nonce   uint64                      `json:"nonce"`
+	Code    string                      `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// prestateDiff is the result of a prestateTracer run in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// runPrestateTracer applies the transaction on top of the given allocation with
+// the prestateTracer, in both pre and diff modes, and returns the results along
+// with the gas used. The given priority transactors are cached in the state as
+// block processing would.
+func runPrestateTracer(t *testing.T, config *params.ChainConfig, alloc core.GenesisAlloc, transactors common.PriorityTransactorMap, tx *types.Transaction, baseFee *big.Int, noBaseFee bool) (map[common.Address]*prestateAccount, *prestateDiff, uint64) {
+	var (
+		pre     map[common.Address]*prestateAccount
+		diff    = new(prestateDiff)
+		gasUsed uint64
+	)
+	for _, cfg := range []string{`{}`, `{"diffMode": true}`} {
+		var (
+			signer     = types.MakeSigner(config, big.NewInt(1))
+			_, statedb = tests.MakePreState(rawdb.NewMemoryDatabase(), alloc, false)
+			context    = vm.BlockContext{
+				CanTransfer: core.CanTransfer,
+				Transfer:    core.Transfer,
+				Coinbase:    common.HexToAddress("0xc0ffee"),
+				BlockNumber: big.NewInt(1),
+				Time:        big.NewInt(1),
+				Difficulty:  big.NewInt(1),
+				GasLimit:    10_000_000,
+				BaseFee:     baseFee,
+			}
+		)
+		statedb.SetPriorityTransactors(transactors)
+
+		msg, err := tx.AsMessage(signer, baseFee)
+		if err != nil {
+			t.Fatalf("failed to prepare transaction for tracing: %v", err)
+		}
+		tracer, err := tracers.New("prestateTracer", new(tracers.Context), json.RawMessage(cfg))
+		if err != nil {
+			t.Fatalf("failed to create prestate tracer: %v", err)
+		}
+		evm := vm.NewEVM(context, core.NewEVMTxContext(msg), statedb, config, vm.Config{Debug: true, Tracer: tracer, NoBaseFee: noBaseFee})
+		result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.Gas()))
+		if err != nil {
+			t.Fatalf("failed to execute transaction: %v", err)
+		}
+		gasUsed = result.UsedGas
+
+		res, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("failed to retrieve trace result: %v", err)
+		}
+		if cfg == `{}` {
+			err = json.Unmarshal(res, &pre)
+		} else {
+			err = json.Unmarshal(res, diff)
+		}
+		if err != nil {
+			t.Fatalf("failed to unmarshal trace result: %v", err)
+		}
+	}
+	return pre, diff, gasUsed
+}
+
+// Tests that the diff mode of the prestateTracer reports the changed accounts
+// and storage slots only, including the fee paid to the coinbase.
+func TestPrestateTracerDiffMode(t *testing.T) {
+	var (
+		key, _   = crypto.GenerateKey()
+		from     = crypto.PubkeyToAddress(key.PublicKey)
+		contract = common.HexToAddress("0xc0de")
+		coinbase = common.HexToAddress("0xc0ffee")
+		baseFee  = big.NewInt(params.GWei)
+		tipCap   = big.NewInt(2)
+		config   = &params.ChainConfig{ChainID: big.NewInt(1), HomesteadBlock: big.NewInt(0), EIP150Block: big.NewInt(0), EIP155Block: big.NewInt(0), EIP158Block: big.NewInt(0), ByzantiumBlock: big.NewInt(0), ConstantinopleBlock: big.NewInt(0), PetersburgBlock: big.NewInt(0), IstanbulBlock: big.NewInt(0), BerlinBlock: big.NewInt(0), LondonBlock: big.NewInt(0)}
+		alloc    = core.GenesisAlloc{
+			from: {Balance: big.NewInt(params.Ether)},
+			contract: {
+				// sstore(1, 0x2a) sload(2)
+				Code:    common.FromHex("602a600155600254500000"),
+				Storage: map[common.Hash]common.Hash{common.HexToHash("0x01"): common.HexToHash("0x01"), common.HexToHash("0x02"): common.HexToHash("0x05")},
+			},
+		}
+	)
+	tx := types.MustSignNewTx(key, types.LatestSigner(config), &types.DynamicFeeTx{
+		ChainID:   config.ChainID,
+		GasTipCap: tipCap,
+		GasFeeCap: new(big.Int).Mul(baseFee, big.NewInt(2)),
+		Gas:       100000,
+		To:        &contract,
+		Value:     big.NewInt(1),
+	})
+	pre, diff, gasUsed := runPrestateTracer(t, config, alloc, nil, tx, baseFee, false)
+
+	// The pre mode reconstructs the sender balance prior to buying the gas
+	if have, want := pre[from].Balance, hexutil.EncodeBig(big.NewInt(params.Ether)); have != want {
+		t.Errorf("pre mode sender balance mismatch: have %s, want %s", have, want)
+	}
+	if have := len(pre[contract].Storage); have != 2 {
+		t.Errorf("pre mode storage slot count mismatch: have %d, want 2", have)
+	}
+	// The diff mode only carries the modified slot, and the paid fees
+	if have := len(diff.Pre); have != 3 {
+		t.Errorf("diff pre account count mismatch: have %d, want 3", have)
+	}
+	if have := diff.Pre[contract].Storage; len(have) != 1 || have[common.HexToHash("0x01")] != common.HexToHash("0x01") {
+		t.Errorf("diff pre storage mismatch: have %v", have)
+	}
+	if have := diff.Post[contract].Storage; len(have) != 1 || have[common.HexToHash("0x01")] != common.HexToHash("0x2a") {
+		t.Errorf("diff post storage mismatch: have %v", have)
+	}
+	if have, want := diff.Post[contract].Balance, "0x1"; have != want {
+		t.Errorf("diff post contract balance mismatch: have %s, want %s", have, want)
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), new(big.Int).Add(baseFee, tipCap))
+	if have, want := diff.Post[from].Balance, hexutil.EncodeBig(new(big.Int).Sub(big.NewInt(params.Ether-1), fee)); have != want {
+		t.Errorf("diff post sender balance mismatch: have %s, want %s", have, want)
+	}
+	if have := diff.Post[from].Nonce; have != 1 {
+		t.Errorf("diff post sender nonce mismatch: have %d, want 1", have)
+	}
+	if have, want := diff.Post[coinbase].Balance, hexutil.EncodeBig(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), tipCap)); have != want {
+		t.Errorf("diff post coinbase balance mismatch: have %s, want %s", have, want)
+	}
+}
+
+// Tests that the prestateTracer credits the gas back to the account that paid
+// for it: the sponsor of a sponsored transaction, or no one for a transaction
+// without a gas price, as for waived priority transactions.
+func TestPrestateTracerFeePayer(t *testing.T) {
+	var (
+		key, _        = crypto.GenerateKey()
+		sponsorKey, _ = crypto.GenerateKey()
+		from          = crypto.PubkeyToAddress(key.PublicKey)
+		sponsor       = crypto.PubkeyToAddress(sponsorKey.PublicKey)
+		coinbase      = common.HexToAddress("0xc0ffee")
+		to            = common.HexToAddress("0xdead")
+		baseFee       = big.NewInt(params.GWei)
+		config        = &params.ChainConfig{ChainID: big.NewInt(1), HomesteadBlock: big.NewInt(0), EIP150Block: big.NewInt(0), EIP155Block: big.NewInt(0), EIP158Block: big.NewInt(0), ByzantiumBlock: big.NewInt(0), ConstantinopleBlock: big.NewInt(0), PetersburgBlock: big.NewInt(0), IstanbulBlock: big.NewInt(0), BerlinBlock: big.NewInt(0), LondonBlock: big.NewInt(0), SponsoredTxBlock: big.NewInt(0)}
+		alloc         = core.GenesisAlloc{
+			from:    {Balance: big.NewInt(100)},
+			sponsor: {Balance: big.NewInt(params.Ether)},
+		}
+	)
+	// Sponsored transaction: the value is paid by the sender, the gas by the sponsor
+	tx, err := types.SignNewSponsoredTx(key, sponsorKey, types.LatestSigner(config), &types.SponsoredTx{
+		ChainID:   config.ChainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: new(big.Int).Mul(baseFee, big.NewInt(2)),
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign sponsored tx: %v", err)
+	}
+	pre, diff, gasUsed := runPrestateTracer(t, config, alloc, nil, tx, baseFee, false)
+	if have, want := pre[from].Balance, "0x64"; have != want {
+		t.Errorf("sender pre balance mismatch: have %s, want %s", have, want)
+	}
+	if have, want := pre[sponsor].Balance, hexutil.EncodeBig(big.NewInt(params.Ether)); have != want {
+		t.Errorf("sponsor pre balance mismatch: have %s, want %s", have, want)
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), new(big.Int).Add(baseFee, big.NewInt(1)))
+	if have, want := diff.Post[sponsor].Balance, hexutil.EncodeBig(new(big.Int).Sub(big.NewInt(params.Ether), fee)); have != want {
+		t.Errorf("sponsor post balance mismatch: have %s, want %s", have, want)
+	}
+	if have, want := diff.Post[from].Balance, "0x0"; have != want {
+		t.Errorf("sender post balance mismatch: have %s, want %s", have, want)
+	}
+	// Transaction without a gas price: only the value moves, the coinbase is untouched
+	tx = types.MustSignNewTx(key, types.LatestSigner(config), &types.DynamicFeeTx{
+		ChainID:   config.ChainID,
+		GasTipCap: common.Big0,
+		GasFeeCap: common.Big0,
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(40),
+	})
+	pre, diff, _ = runPrestateTracer(t, config, alloc, nil, tx, baseFee, true)
+	if have, want := pre[from].Balance, "0x64"; have != want {
+		t.Errorf("sender pre balance mismatch: have %s, want %s", have, want)
+	}
+	if have, want := diff.Post[from].Balance, "0x3c"; have != want {
+		t.Errorf("sender post balance mismatch: have %s, want %s", have, want)
+	}
+	if _, ok := diff.Post[coinbase]; ok {
+		t.Errorf("unpaid coinbase reported as modified")
+	}
+	if _, ok := diff.Pre[coinbase]; ok {
+		t.Errorf("unpaid coinbase reported in the pre state")
+	}
+}
+
+// Tests that the diff mode of the prestateTracer omits the post state of
+// self-destructed accounts, and the pre state of created contracts.
+func TestPrestateTracerDiffModeCreateDestroy(t *testing.T) {
+	var (
+		key, _      = crypto.GenerateKey()
+		from        = crypto.PubkeyToAddress(key.PublicKey)
+		contract    = common.HexToAddress("0xc0de")
+		beneficiary = common.HexToAddress("0xbeef")
+		created     = crypto.CreateAddress(from, 1)
+		config      = &params.ChainConfig{ChainID: big.NewInt(1), HomesteadBlock: big.NewInt(0), EIP150Block: big.NewInt(0), EIP155Block: big.NewInt(0), EIP158Block: big.NewInt(0), ByzantiumBlock: big.NewInt(0), ConstantinopleBlock: big.NewInt(0), PetersburgBlock: big.NewInt(0), IstanbulBlock: big.NewInt(0), BerlinBlock: big.NewInt(0), LondonBlock: big.NewInt(0)}
+		alloc       = core.GenesisAlloc{
+			from: {Balance: big.NewInt(params.Ether), Nonce: 1},
+			contract: {
+				// selfdestruct(0xbeef)
+				Code:    append(append([]byte{byte(vm.PUSH20)}, beneficiary.Bytes()...), byte(vm.SELFDESTRUCT)),
+				Balance: big.NewInt(7),
+			},
+		}
+	)
+	signer := types.LatestSigner(config)
+	tx := types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: config.ChainID, Nonce: 1, GasFeeCap: big.NewInt(params.GWei), Gas: 100000, To: &contract})
+	_, diff, _ := runPrestateTracer(t, config, alloc, nil, tx, big.NewInt(params.GWei), false)
+	if _, ok := diff.Pre[contract]; !ok {
+		t.Errorf("self-destructed contract missing from the pre state")
+	}
+	if _, ok := diff.Post[contract]; ok {
+		t.Errorf("self-destructed contract reported in the post state")
+	}
+	if have, want := diff.Post[beneficiary].Balance, "0x7"; have != want {
+		t.Errorf("beneficiary post balance mismatch: have %s, want %s", have, want)
+	}
+	// Contract creation deploying a single STOP opcode
+	tx = types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: config.ChainID, Nonce: 1, GasFeeCap: big.NewInt(params.GWei), Gas: 100000, Value: big.NewInt(3), Data: common.FromHex("600160005360016000f3")})
+	pre, diff, _ := runPrestateTracer(t, config, alloc, nil, tx, big.NewInt(params.GWei), false)
+	if _, ok := pre[created]; ok {
+		t.Errorf("created contract reported in the pre mode state")
+	}
+	if _, ok := diff.Pre[created]; ok {
+		t.Errorf("created contract reported in the diff pre state")
+	}
+	post := diff.Post[created]
+	if post == nil {
+		t.Fatalf("created contract missing from the post state")
+	}
+	if post.Nonce != 1 || post.Balance != "0x3" || post.Code != "0x01" {
+		t.Errorf("created contract post state mismatch: have %+v", post)
+	}
+}
+
+// Tests that the diff mode of the prestateTracer reports a gas-waived priority
+// transaction as paying the value only: the sender is not charged any gas and
+// the coinbase is not credited any tip.
+func TestPrestateTracerDiffModePriorityWaiver(t *testing.T) {
+	var (
+		key, _         = crypto.GenerateKey()
+		priorityKey, _ = crypto.GenerateKey()
+		from           = crypto.PubkeyToAddress(key.PublicKey)
+		coinbase       = common.HexToAddress("0xc0ffee")
+		to             = common.HexToAddress("0xdead")
+		baseFee        = big.NewInt(params.GWei)
+		config         = &params.ChainConfig{ChainID: big.NewInt(1), HomesteadBlock: big.NewInt(0), EIP150Block: big.NewInt(0), EIP155Block: big.NewInt(0), EIP158Block: big.NewInt(0), ByzantiumBlock: big.NewInt(0), ConstantinopleBlock: big.NewInt(0), PetersburgBlock: big.NewInt(0), IstanbulBlock: big.NewInt(0), BerlinBlock: big.NewInt(0), LondonBlock: big.NewInt(0)}
+		alloc          = core.GenesisAlloc{
+			from:     {Balance: big.NewInt(100)},
+			coinbase: {Balance: big.NewInt(5)},
+		}
+		pubkey common.PublicKey
+	)
+	copy(pubkey[:], crypto.FromECDSAPub(&priorityKey.PublicKey))
+	transactors := common.PriorityTransactorMap{
+		pubkey: {EntityName: "Waiver Entity", IsGasPriceWaiver: true},
+	}
+	tx, err := types.SignNewPriorityTx(key, priorityKey, types.LatestSigner(config), &types.PriorityTx{
+		ChainID:   config.ChainID,
+		GasTipCap: common.Big0,
+		GasFeeCap: common.Big0,
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(40),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign priority tx: %v", err)
+	}
+	pre, diff, gasUsed := runPrestateTracer(t, config, alloc, transactors, tx, baseFee, false)
+	if gasUsed == 0 {
+		t.Fatalf("waived priority tx used no gas")
+	}
+	if have, want := pre[from].Balance, "0x64"; have != want {
+		t.Errorf("sender pre balance mismatch: have %s, want %s", have, want)
+	}
+	if have, want := pre[coinbase].Balance, "0x5"; have != want {
+		t.Errorf("coinbase pre balance mismatch: have %s, want %s", have, want)
+	}
+	if have, want := diff.Pre[from].Balance, "0x64"; have != want {
+		t.Errorf("diff pre sender balance mismatch: have %s, want %s", have, want)
+	}
+	if have, want := diff.Post[from].Balance, "0x3c"; have != want {
+		t.Errorf("diff post sender balance mismatch: have %s, want %s", have, want)
+	}
+	if have := diff.Post[from].Nonce; have != 1 {
+		t.Errorf("diff post sender nonce mismatch: have %d, want 1", have)
+	}
+	if have, want := diff.Post[to].Balance, "0x28"; have != want {
+		t.Errorf("diff post recipient balance mismatch: have %s, want %s", have, want)
+	}
+	if pre, ok := diff.Pre[coinbase]; ok {
+		t.Errorf("unpaid coinbase reported in the diff pre state: %+v", pre)
+	}
+	if post, ok := diff.Post[coinbase]; ok {
+		t.Errorf("unpaid coinbase reported in the diff post state: %+v", post)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/eth/tracers"
+	"github.com/electroneum/electroneum-sc/ethdb"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+// chainBackend is a tracers.Backend over a generated chain, used to exercise
+// the trace namespace with the native tracers linked in.
+type chainBackend struct {
+	config  *params.ChainConfig
+	engine  consensus.Engine
+	chaindb ethdb.Database
+	chain   *core.BlockChain
+}
+
+func newChainBackend(t *testing.T, n int, gspec *core.Genesis, generator func(i int, b *core.BlockGen)) *chainBackend {
+	backend := &chainBackend{
+		config:  gspec.Config,
+		engine:  ethash.NewFaker(),
+		chaindb: rawdb.NewMemoryDatabase(),
+	}
+	gendb := rawdb.NewMemoryDatabase()
+	blocks, _ := core.GenerateChain(backend.config, gspec.MustCommit(gendb), backend.engine, gendb, n, generator)
+
+	gspec.MustCommit(backend.chaindb)
+	cacheConfig := &core.CacheConfig{
+		TrieCleanLimit:    256,
+		TrieDirtyLimit:    256,
+		TrieTimeLimit:     5 * time.Minute,
+		TrieDirtyDisabled: true, // Archive mode
+	}
+	chain, err := core.NewBlockChain(backend.chaindb, cacheConfig, backend.config, backend.engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	backend.chain = chain
+	return backend
+}
+
+func (b *chainBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return b.chain.GetHeaderByHash(hash), nil
+}
+
+func (b *chainBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber {
+		return b.chain.CurrentHeader(), nil
+	}
+	return b.chain.GetHeaderByNumber(uint64(number)), nil
+}
+
+func (b *chainBackend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return b.chain.GetBlockByHash(hash), nil
+}
+
+func (b *chainBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	if number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber {
+		return b.chain.CurrentBlock(), nil
+	}
+	return b.chain.GetBlockByNumber(uint64(number)), nil
+}
+
+func (b *chainBackend) GetTransaction(ctx context.Context, txHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error) {
+	tx, hash, blockNumber, index := rawdb.ReadTransaction(b.chaindb, txHash)
+	if tx == nil {
+		return nil, common.Hash{}, 0, 0, errors.New("transaction not found")
+	}
+	return tx, hash, blockNumber, index, nil
+}
+
+func (b *chainBackend) RPCGasCap() uint64                { return 25000000 }
+func (b *chainBackend) ChainConfig() *params.ChainConfig { return b.config }
+func (b *chainBackend) Engine() consensus.Engine         { return b.engine }
+func (b *chainBackend) ChainDb() ethdb.Database          { return b.chaindb }
+
+func (b *chainBackend) StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, checkLive bool, preferDisk bool) (*state.StateDB, error) {
+	return b.chain.StateAt(block.Root())
+}
+
+func (b *chainBackend) StateAtTransaction(ctx context.Context, block *types.Block, txIndex int, reexec uint64) (core.Message, vm.BlockContext, *state.StateDB, error) {
+	parent := b.chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, vm.BlockContext{}, nil, errors.New("parent block not found")
+	}
+	statedb, err := b.chain.StateAt(parent.Root())
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	header := block.Header()
+	context := core.NewEVMBlockContext(header, b.chain, nil)
+	statedb.SetPriorityTransactors(core.GetPriorityTransactors(vm.NewEVM(context, vm.TxContext{}, statedb, b.config, vm.Config{})))
+	for idx, tx := range block.Transactions() {
+		msg, err := core.TransactionToMessage(b.config, header, tx, statedb.GetPriorityTransactorByKey)
+		if err != nil {
+			return nil, vm.BlockContext{}, nil, err
+		}
+		if idx == txIndex {
+			return msg, context, statedb, nil
+		}
+		vmenv := vm.NewEVM(context, core.NewEVMTxContext(msg), statedb, b.config, vm.Config{})
+		if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(tx.Gas())); err != nil {
+			return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction %#x failed: %v", tx.Hash(), err)
+		}
+		core.RefreshPriorityTransactors(vmenv, msg)
+		statedb.Finalise(true)
+	}
+	return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction index %d out of range for block %#x", txIndex, block.Hash())
+}
+
+// Tests that the trace namespace serves blocks containing a priority transaction
+// whose gas price is waived by the priority transactors contract.
+func TestTraceAPIPriorityWaiver(t *testing.T) {
+	var (
+		key, _         = crypto.GenerateKey()
+		priorityKey, _ = crypto.GenerateKey()
+		from           = crypto.PubkeyToAddress(key.PublicKey)
+		to             = common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		contract       = common.HexToAddress("0x9999999999999999999999999999999999999999")
+	)
+	code, err := prioritytransactors.StubCode(common.PriorityTransactorMap{
+		common.BytesToPublicKey(crypto.FromECDSAPub(&priorityKey.PublicKey)): {IsGasPriceWaiver: true, EntityName: "Waiver Entity"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create priority contract code: %v", err)
+	}
+	config := *params.TestChainConfig
+	config.PriorityTransactorsContractAddress = contract
+
+	genesis := &core.Genesis{Config: &config, Alloc: core.GenesisAlloc{
+		from:     {Balance: big.NewInt(params.Ether)},
+		contract: {Code: code, Balance: new(big.Int)},
+	}}
+	var hashes []common.Hash
+	backend := newChainBackend(t, 1, genesis, func(i int, b *core.BlockGen) {
+		signer := types.LatestSigner(&config)
+		waived, _ := types.SignNewPriorityTx(key, priorityKey, signer, &types.PriorityTx{
+			ChainID:   config.ChainID,
+			GasTipCap: new(big.Int),
+			GasFeeCap: new(big.Int),
+			Gas:       params.TxGas,
+			To:        &to,
+			Value:     big.NewInt(1000),
+		})
+		b.AddTx(waived)
+		paid, _ := types.SignTx(types.NewTransaction(1, to, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, key)
+		b.AddTx(paid)
+		hashes = append(hashes, waived.Hash(), paid.Hash())
+	})
+	api := tracers.NewTraceAPI(backend)
+
+	check := func(name string, traces []json.RawMessage, positions ...uint64) {
+		t.Helper()
+		if len(traces) != len(positions) {
+			t.Fatalf("%s: trace count mismatch: have %d, want %d", name, len(traces), len(positions))
+		}
+		for i, raw := range traces {
+			var trace flatCallTrace
+			if err := json.Unmarshal(raw, &trace); err != nil {
+				t.Fatalf("%s: failed to unmarshal trace %d: %v", name, i, err)
+			}
+			if trace.Type != "call" || trace.Action.From == nil || *trace.Action.From != from || trace.Error != "" {
+				t.Errorf("%s: trace %d mismatch: %s", name, i, raw)
+			}
+			if trace.TransactionPosition != positions[i] || trace.TransactionHash == nil || *trace.TransactionHash != hashes[positions[i]] {
+				t.Errorf("%s: trace %d transaction mismatch: %s", name, i, raw)
+			}
+		}
+	}
+	traces, err := api.Block(context.Background(), rpc.BlockNumber(1))
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	check("block", traces, 0, 1)
+
+	number := rpc.BlockNumber(1)
+	traces, err = api.Filter(context.Background(), tracers.TraceFilterArgs{FromBlock: &number, ToBlock: &number, FromAddress: []common.Address{from}})
+	if err != nil {
+		t.Fatalf("failed to filter traces: %v", err)
+	}
+	check("filter", traces, 0, 1)
+
+	for i, hash := range hashes {
+		traces, err := api.Transaction(context.Background(), hash)
+		if err != nil {
+			t.Fatalf("failed to trace transaction %d: %v", i, err)
+		}
+		check(fmt.Sprintf("transaction %d", i), traces, uint64(i))
+	}
+}
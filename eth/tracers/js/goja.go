@@ -131,7 +131,7 @@ type jsTracer struct {
 // The methods `result` and `fault` are required to be present.
 // The methods `step`, `enter`, and `exit` are optional, but note that
 // `enter` and `exit` always go together.
-func newJsTracer(code string, ctx *tracers.Context) (tracers.Tracer, error) {
+func newJsTracer(code string, ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
 	if c, ok := assetTracers[code]; ok {
 		code = c
 	}
@@ -196,6 +196,16 @@ func newJsTracer(code string, ctx *tracers.Context) (tracers.Tracer, error) {
 	t.frameValue = t.frame.setupObject()
 	t.frameResultValue = t.frameResult.setupObject()
 	t.logValue = t.log.setupObject()
+
+	// Hand the config over to the tracer, if it exposes a setup(config) method
+	if setup, ok := goja.AssertFunction(obj.Get("setup")); ok {
+		if cfg == nil {
+			cfg = json.RawMessage("{}")
+		}
+		if _, err := setup(obj, vm.ToValue(string(cfg))); err != nil {
+			return nil, err
+		}
+	}
 	return t, nil
 }
 
@@ -0,0 +1,363 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/eth/tracers"
+)
+
+func init() {
+	register("flatCallTracer", newFlatCallTracer)
+}
+
+// parityErrorMapping maps the EVM errors to their Parity/OpenEthereum wording.
+var parityErrorMapping = map[string]string{
+	"contract creation code storage out of gas": "Out of gas",
+	"out of gas":                      "Out of gas",
+	"gas uint64 overflow":             "Out of gas",
+	"max code size exceeded":          "Out of gas",
+	"invalid jump destination":        "Bad jump destination",
+	"execution reverted":              "Reverted",
+	"return data out of bounds":       "Out of bounds",
+	"stack limit reached 1024 (1023)": "Out of stack",
+	"precompiled failed":              "Built-in failed",
+	"invalid input length":            "Built-in failed",
+}
+
+// parityErrorMappingStartingWith maps the EVM errors carrying details to their
+// Parity/OpenEthereum wording.
+var parityErrorMappingStartingWith = map[string]string{
+	"invalid opcode:": "Bad instruction",
+	"stack underflow": "Stack underflow",
+}
+
+// flatCallAction is the action of a Parity style trace. Depending on the trace
+// type, only the relevant fields are set.
+type flatCallAction struct {
+	Author         *common.Address `json:"author,omitempty"`
+	RewardType     string          `json:"rewardType,omitempty"`
+	SelfDestructed *common.Address `json:"address,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+}
+
+// flatCallResult is the result of a Parity style call or create trace.
+type flatCallResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// flatCallFrame is a single Parity style trace.
+type flatCallFrame struct {
+	Action              flatCallAction  `json:"action"`
+	BlockHash           *common.Hash    `json:"blockHash"`
+	BlockNumber         uint64          `json:"blockNumber"`
+	Error               string          `json:"error,omitempty"`
+	Result              *flatCallResult `json:"result,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *common.Hash    `json:"transactionHash"`
+	TransactionPosition uint64          `json:"transactionPosition"`
+	Type                string          `json:"type"`
+}
+
+// flatCallTracer reports the call frames of a transaction as a flat list of
+// Parity/OpenEthereum style traces, as returned by the trace_ namespace. It is
+// built on top of the callTracer, flattening its nested frames.
+type flatCallTracer struct {
+	tracer            *callTracer
+	ctx               *tracers.Context // Context of the traced transaction, to fill the traces with
+	activePrecompiles []common.Address // Updated on CaptureStart based on given rules
+}
+
+// newFlatCallTracer returns a native go tracer which reports the call frames of
+// a transaction in the Parity trace format, and implements vm.EVMLogger.
+func newFlatCallTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	tracer, err := newCallTracer(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &flatCallTracer{
+		tracer: tracer.(*callTracer),
+		ctx:    ctx,
+	}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *flatCallTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.tracer.CaptureStart(env, from, to, create, input, gas, value)
+
+	// Update the list of precompiles based on the current block
+	rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil)
+	t.activePrecompiles = vm.ActivePrecompiles(rules)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *flatCallTracer) CaptureEnd(output []byte, gasUsed uint64, elapsed time.Duration, err error) {
+	t.tracer.CaptureEnd(output, gasUsed, elapsed, err)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *flatCallTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *flatCallTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *flatCallTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.tracer.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *flatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.tracer.CaptureExit(output, gasUsed, err)
+
+	// Parity traces don't include plain calls to precompiles, drop them
+	size := len(t.tracer.callstack)
+	if size == 0 {
+		return
+	}
+	parent := &t.tracer.callstack[size-1]
+	if len(parent.Calls) == 0 {
+		return
+	}
+	call := parent.Calls[len(parent.Calls)-1]
+	if call.Type == vm.CALL.String() || call.Type == vm.STATICCALL.String() {
+		if t.isPrecompiled(common.HexToAddress(call.To)) {
+			parent.Calls = parent.Calls[:len(parent.Calls)-1]
+		}
+	}
+}
+
+func (t *flatCallTracer) CaptureTxStart(gasLimit uint64) {
+	t.tracer.CaptureTxStart(gasLimit)
+}
+
+func (t *flatCallTracer) CaptureTxEnd(restGas uint64) {
+	t.tracer.CaptureTxEnd(restGas)
+}
+
+// GetResult returns the json-encoded flat list of call traces, and any error
+// arising from the encoding or forceful termination (via `Stop`).
+func (t *flatCallTracer) GetResult() (json.RawMessage, error) {
+	if len(t.tracer.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	frames, err := t.flatten(&t.tracer.callstack[0], []int{})
+	if err != nil {
+		return nil, err
+	}
+	res, err := json.Marshal(frames)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.tracer.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *flatCallTracer) Stop(err error) {
+	t.tracer.Stop(err)
+}
+
+// isPrecompiled returns whether the address is one of the active precompiles.
+func (t *flatCallTracer) isPrecompiled(addr common.Address) bool {
+	for _, p := range t.activePrecompiles {
+		if p == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// flatten converts a nested call frame and its children into Parity style
+// traces, depth first.
+func (t *flatCallTracer) flatten(call *callFrame, traceAddress []int) ([]flatCallFrame, error) {
+	var frame *flatCallFrame
+	switch call.Type {
+	case vm.CREATE.String(), vm.CREATE2.String():
+		frame = newFlatCreate(call)
+	case vm.SELFDESTRUCT.String():
+		frame = newFlatSuicide(call)
+	case vm.CALL.String(), vm.STATICCALL.String(), vm.CALLCODE.String(), vm.DELEGATECALL.String():
+		frame = newFlatCall(call)
+	default:
+		return nil, fmt.Errorf("unrecognized call frame type: %s", call.Type)
+	}
+	frame.TraceAddress = traceAddress
+	frame.Subtraces = len(call.Calls)
+	if call.Error != "" {
+		frame.Error = parityError(call.Error)
+
+		// Reverts keep their output as it carries the revert reason
+		if call.Error != vm.ErrExecutionReverted.Error() {
+			frame.Result = nil
+		}
+	}
+	if t.ctx != nil {
+		if t.ctx.BlockHash != (common.Hash{}) {
+			frame.BlockHash = &t.ctx.BlockHash
+		}
+		if t.ctx.BlockNumber != nil {
+			frame.BlockNumber = t.ctx.BlockNumber.Uint64()
+		}
+		if t.ctx.TxHash != (common.Hash{}) {
+			frame.TransactionHash = &t.ctx.TxHash
+		}
+		frame.TransactionPosition = uint64(t.ctx.TxIndex)
+	}
+	frames := []flatCallFrame{*frame}
+	for i := range call.Calls {
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = i
+
+		children, err := t.flatten(&call.Calls[i], childAddress)
+		if err != nil {
+			return nil, err
+		}
+		frames = append(frames, children...)
+	}
+	return frames, nil
+}
+
+// newFlatCreate returns the Parity style trace of a contract creation.
+func newFlatCreate(call *callFrame) *flatCallFrame {
+	var (
+		from    = common.HexToAddress(call.From)
+		gas     = hexToUint64(call.Gas)
+		gasUsed = hexToUint64(call.GasUsed)
+		init    = hexToBytes(call.Input)
+		code    = hexToBytes(call.Output)
+	)
+	frame := &flatCallFrame{
+		Type: "create",
+		Action: flatCallAction{
+			CreationMethod: strings.ToLower(call.Type),
+			From:           &from,
+			Gas:            &gas,
+			Init:           &init,
+			Value:          hexToBig(call.Value),
+		},
+		Result: &flatCallResult{
+			GasUsed: &gasUsed,
+			Code:    &code,
+		},
+	}
+	if call.To != "" {
+		address := common.HexToAddress(call.To)
+		frame.Result.Address = &address
+	}
+	return frame
+}
+
+// newFlatCall returns the Parity style trace of a message call.
+func newFlatCall(call *callFrame) *flatCallFrame {
+	var (
+		from    = common.HexToAddress(call.From)
+		to      = common.HexToAddress(call.To)
+		gas     = hexToUint64(call.Gas)
+		gasUsed = hexToUint64(call.GasUsed)
+		input   = hexToBytes(call.Input)
+		output  = hexToBytes(call.Output)
+	)
+	return &flatCallFrame{
+		Type: "call",
+		Action: flatCallAction{
+			CallType: strings.ToLower(call.Type),
+			From:     &from,
+			To:       &to,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    hexToBig(call.Value),
+		},
+		Result: &flatCallResult{
+			GasUsed: &gasUsed,
+			Output:  &output,
+		},
+	}
+}
+
+// newFlatSuicide returns the Parity style trace of a self-destruct.
+func newFlatSuicide(call *callFrame) *flatCallFrame {
+	var (
+		address = common.HexToAddress(call.From)
+		refund  = common.HexToAddress(call.To)
+	)
+	return &flatCallFrame{
+		Type: "suicide",
+		Action: flatCallAction{
+			SelfDestructed: &address,
+			Balance:        hexToBig(call.Value),
+			RefundAddress:  &refund,
+		},
+	}
+}
+
+// parityError converts an EVM error to its Parity/OpenEthereum wording.
+func parityError(err string) string {
+	if mapped, ok := parityErrorMapping[err]; ok {
+		return mapped
+	}
+	for prefix, mapped := range parityErrorMappingStartingWith {
+		if strings.HasPrefix(err, prefix) {
+			return mapped
+		}
+	}
+	return err
+}
+
+func hexToUint64(s string) hexutil.Uint64 {
+	n, _ := hexutil.DecodeUint64(s)
+	return hexutil.Uint64(n)
+}
+
+func hexToBytes(s string) hexutil.Bytes {
+	return common.FromHex(s)
+}
+
+// hexToBig decodes a hex quantity, defaulting to zero as Parity traces always
+// carry a value, even for static calls.
+func hexToBig(s string) *hexutil.Big {
+	n, err := hexutil.DecodeBig(s)
+	if err != nil {
+		n = new(big.Int)
+	}
+	return (*hexutil.Big)(n)
+}
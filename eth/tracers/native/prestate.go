@@ -41,20 +41,55 @@ type account struct {
 	Storage map[common.Hash]common.Hash `json:"storage"`
 }
 
+// exists returns whether the account existed, i.e. is not empty.
+func (a *account) exists() bool {
+	return a.Nonce > 0 || a.Code != "0x" || a.Balance != "0x0"
+}
+
+// poststate is the state of the modified accounts after the transaction, in
+// diff mode. Only the changed fields of an account are set.
+type poststate = map[common.Address]*accountDiff
+type accountDiff struct {
+	Balance string                      `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    string                      `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
 type prestateTracer struct {
 	env       *vm.EVM
 	prestate  prestate
+	poststate poststate
 	create    bool
 	to        common.Address
 	gasLimit  uint64 // Amount of gas bought for the whole tx
+	config    prestateTracerConfig
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
+	created   map[common.Address]bool
+	deleted   map[common.Address]bool
+}
+
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"` // If true, this tracer will return state modifications
 }
 
-func newPrestateTracer(ctx *tracers.Context) tracers.Tracer {
+func newPrestateTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config prestateTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
 	// First callframe contains tx context info
 	// and is populated on start and end.
-	return &prestateTracer{prestate: prestate{}}
+	return &prestateTracer{
+		prestate:  prestate{},
+		poststate: poststate{},
+		config:    config,
+		created:   make(map[common.Address]bool),
+		deleted:   make(map[common.Address]bool),
+	}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -63,29 +98,58 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 	t.create = create
 	t.to = to
 
+	// The gas is paid by the sponsor of sponsored transactions, and the fees
+	// end up with the coinbase.
+	payer := from
+	if env.TxContext.Sponsor != nil {
+		payer = *env.TxContext.Sponsor
+	}
 	t.lookupAccount(from)
 	t.lookupAccount(to)
+	t.lookupAccount(payer)
+	t.lookupAccount(env.Context.Coinbase)
 
 	// The recipient balance includes the value transferred.
 	toBal := hexutil.MustDecodeBig(t.prestate[to].Balance)
 	toBal = new(big.Int).Sub(toBal, value)
 	t.prestate[to].Balance = hexutil.EncodeBig(toBal)
 
-	// The sender balance is after reducing: value and gasLimit.
-	// We need to re-add them to get the pre-tx balance.
+	// The sender balance is after reducing the value, and the payer balance
+	// after reducing the gasLimit. We need to re-add them to get the pre-tx
+	// balances. Priority transactions with a gas price waiver have a zero gas
+	// price, so nothing was bought for them.
 	fromBal := hexutil.MustDecodeBig(t.prestate[from].Balance)
-	gasPrice := env.TxContext.GasPrice
-	consumedGas := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(t.gasLimit))
-	fromBal.Add(fromBal, new(big.Int).Add(value, consumedGas))
+	fromBal.Add(fromBal, value)
 	t.prestate[from].Balance = hexutil.EncodeBig(fromBal)
 	t.prestate[from].Nonce--
+
+	payerBal := hexutil.MustDecodeBig(t.prestate[payer].Balance)
+	gasPrice := env.TxContext.GasPrice
+	consumedGas := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(t.gasLimit))
+	payerBal.Add(payerBal, consumedGas)
+	t.prestate[payer].Balance = hexutil.EncodeBig(payerBal)
+
+	// A created contract has its nonce already bumped, while any account at
+	// its address could only be a funded one without nonce nor code.
+	if create {
+		t.prestate[to].Nonce = 0
+		if t.config.DiffMode {
+			t.created[to] = true
+		}
+	}
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if t.config.DiffMode {
+		return
+	}
 	if t.create {
-		// Exclude created contract.
-		delete(t.prestate, t.to)
+		// Keep existing account prior to contract creation at that address
+		if s := t.prestate[t.to]; s != nil && !s.exists() {
+			// Exclude newly created contract.
+			delete(t.prestate, t.to)
+		}
 	}
 }
 
@@ -101,20 +165,27 @@ func (t *prestateTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64,
 	case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
 		addr := common.Address(stackData[stackLen-1].Bytes20())
 		t.lookupAccount(addr)
+		if op == vm.SELFDESTRUCT {
+			t.deleted[scope.Contract.Address()] = true
+		}
 	case stackLen >= 5 && (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE):
 		addr := common.Address(stackData[stackLen-2].Bytes20())
 		t.lookupAccount(addr)
 	case op == vm.CREATE:
 		addr := scope.Contract.Address()
 		nonce := t.env.StateDB.GetNonce(addr)
-		t.lookupAccount(crypto.CreateAddress(addr, nonce))
+		created := crypto.CreateAddress(addr, nonce)
+		t.lookupAccount(created)
+		t.created[created] = true
 	case stackLen >= 4 && op == vm.CREATE2:
 		offset := stackData[stackLen-2]
 		size := stackData[stackLen-3]
 		init := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
 		inithash := crypto.Keccak256(init)
 		salt := stackData[stackLen-4]
-		t.lookupAccount(crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash))
+		created := crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash)
+		t.lookupAccount(created)
+		t.created[created] = true
 	}
 }
 
@@ -135,12 +206,79 @@ func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
 	t.gasLimit = gasLimit
 }
 
-func (t *prestateTracer) CaptureTxEnd(restGas uint64) {}
+// CaptureTxEnd is called once the transaction is fully applied, including the
+// gas refund and the coinbase fee, so the post state can be collected.
+func (t *prestateTracer) CaptureTxEnd(restGas uint64) {
+	if !t.config.DiffMode || t.env == nil {
+		return
+	}
+	for addr, state := range t.prestate {
+		// The deleted account's state is pruned from post but kept in pre
+		if t.deleted[addr] {
+			continue
+		}
+		var (
+			modified = false
+			post     = &accountDiff{Storage: make(map[common.Hash]common.Hash)}
+		)
+		if balance := bigToHex(t.env.StateDB.GetBalance(addr)); balance != state.Balance {
+			modified = true
+			post.Balance = balance
+		}
+		if nonce := t.env.StateDB.GetNonce(addr); nonce != state.Nonce {
+			modified = true
+			post.Nonce = nonce
+		}
+		if code := bytesToHex(t.env.StateDB.GetCode(addr)); code != state.Code {
+			modified = true
+			post.Code = code
+		}
+		for key, val := range state.Storage {
+			newVal := t.env.StateDB.GetState(addr, key)
+			if val == newVal {
+				// Omit unchanged slots
+				delete(state.Storage, key)
+				continue
+			}
+			modified = true
+			if newVal != (common.Hash{}) {
+				post.Storage[key] = newVal
+			}
+			// Don't include the empty pre slots
+			if val == (common.Hash{}) {
+				delete(state.Storage, key)
+			}
+		}
+		if modified {
+			t.poststate[addr] = post
+		} else {
+			// If the state is not modified, it doesn't belong in the pre state either
+			delete(t.prestate, addr)
+		}
+	}
+	// The newly created contracts' pre states are empty, so delete them. The
+	// created address may have been funded before the transaction though.
+	for addr := range t.created {
+		if s := t.prestate[addr]; s != nil && !s.exists() {
+			delete(t.prestate, addr)
+		}
+	}
+}
 
-// GetResult returns the json-encoded nested list of call traces, and any
-// error arising from the encoding or forceful termination (via `Stop`).
+// GetResult returns the json-encoded prestate of the touched accounts, or in
+// diff mode both the pre and post states of the modified ones, and any error
+// arising from the encoding or forceful termination (via `Stop`).
 func (t *prestateTracer) GetResult() (json.RawMessage, error) {
-	res, err := json.Marshal(t.prestate)
+	var res []byte
+	var err error
+	if t.config.DiffMode {
+		res, err = json.Marshal(struct {
+			Post poststate `json:"post"`
+			Pre  prestate  `json:"pre"`
+		}{t.poststate, t.prestate})
+	} else {
+		res, err = json.Marshal(t.prestate)
+	}
 	if err != nil {
 		return nil, err
 	}
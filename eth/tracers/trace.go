@@ -0,0 +1,327 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/consensus"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+const (
+	// flatCallTracer is the native tracer producing the Parity style traces
+	// served by the trace namespace.
+	flatCallTracer = "flatCallTracer"
+
+	// maxTraceFilterBlocks is the maximum number of blocks a single trace_filter
+	// request may span, as every block in the range is re-executed.
+	maxTraceFilterBlocks = 1000
+)
+
+// TraceAPI is the collection of Parity/OpenEthereum compatible tracing APIs
+// exposed over the trace namespace. The traces are produced by re-executing the
+// transactions with the flatCallTracer.
+type TraceAPI struct {
+	api *API
+}
+
+// NewTraceAPI creates a new trace API instance.
+func NewTraceAPI(backend Backend) *TraceAPI {
+	return &TraceAPI{api: NewAPI(backend)}
+}
+
+// rewardTrace is the Parity style trace of a block reward credited by the
+// consensus engine when finalizing a block.
+type rewardTrace struct {
+	Action              rewardAction `json:"action"`
+	BlockHash           common.Hash  `json:"blockHash"`
+	BlockNumber         uint64       `json:"blockNumber"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash"`
+	TransactionPosition *uint64      `json:"transactionPosition"`
+	Type                string       `json:"type"`
+}
+
+type rewardAction struct {
+	Author     common.Address `json:"author"`
+	RewardType string         `json:"rewardType"`
+	Value      *hexutil.Big   `json:"value"`
+}
+
+// traceAccounts is the subset of a Parity style trace needed to filter it by
+// the accounts involved and to report the call output.
+type traceAccounts struct {
+	Action struct {
+		Author        *common.Address `json:"author"`
+		Address       *common.Address `json:"address"`
+		From          *common.Address `json:"from"`
+		RefundAddress *common.Address `json:"refundAddress"`
+		To            *common.Address `json:"to"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+		Output  hexutil.Bytes   `json:"output"`
+	} `json:"result"`
+}
+
+// from returns the account originating the traced action, if any.
+func (t *traceAccounts) from() *common.Address {
+	if t.Action.From != nil {
+		return t.Action.From
+	}
+	return t.Action.Address
+}
+
+// to returns the account receiving the traced action, if any.
+func (t *traceAccounts) to() *common.Address {
+	switch {
+	case t.Action.To != nil:
+		return t.Action.To
+	case t.Action.RefundAddress != nil:
+		return t.Action.RefundAddress
+	case t.Action.Author != nil:
+		return t.Action.Author
+	case t.Result != nil:
+		return t.Result.Address
+	}
+	return nil
+}
+
+// Block returns the traces of all the transactions in the given block, followed
+// by the traces of the block rewards.
+func (api *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) ([]json.RawMessage, error) {
+	block, err := api.api.blockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(ctx, block)
+}
+
+// Transaction returns the traces of the given transaction.
+func (api *TraceAPI) Transaction(ctx context.Context, hash common.Hash) ([]json.RawMessage, error) {
+	tracer := flatCallTracer
+	res, err := api.api.TraceTransaction(ctx, hash, &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return nil, err
+	}
+	var traces []json.RawMessage
+	if err := json.Unmarshal(res.(json.RawMessage), &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// TraceFilterArgs are the criteria of a trace_filter request.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// Filter returns the traces of the given block range matching the account
+// criteria. An account matches if it is listed in fromAddress or toAddress;
+// when both are given, a trace must match both.
+func (api *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) ([]json.RawMessage, error) {
+	var (
+		from = rpc.EarliestBlockNumber
+		to   = rpc.LatestBlockNumber
+	)
+	if args.FromBlock != nil {
+		from = *args.FromBlock
+	}
+	if args.ToBlock != nil {
+		to = *args.ToBlock
+	}
+	start, err := api.api.blockByNumber(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := api.api.blockByNumber(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	if start.NumberU64() > end.NumberU64() {
+		return nil, fmt.Errorf("invalid block range %d-%d", start.NumberU64(), end.NumberU64())
+	}
+	if end.NumberU64()-start.NumberU64() >= maxTraceFilterBlocks {
+		return nil, fmt.Errorf("block range %d-%d exceeds the limit of %d blocks", start.NumberU64(), end.NumberU64(), maxTraceFilterBlocks)
+	}
+	var (
+		fromSet = make(map[common.Address]struct{}, len(args.FromAddress))
+		toSet   = make(map[common.Address]struct{}, len(args.ToAddress))
+	)
+	for _, addr := range args.FromAddress {
+		fromSet[addr] = struct{}{}
+	}
+	for _, addr := range args.ToAddress {
+		toSet[addr] = struct{}{}
+	}
+	matches := func(set map[common.Address]struct{}, addr *common.Address) bool {
+		if len(set) == 0 {
+			return true
+		}
+		if addr == nil {
+			return false
+		}
+		_, ok := set[*addr]
+		return ok
+	}
+	var (
+		skip    uint64
+		results = []json.RawMessage{}
+	)
+	if args.After != nil {
+		skip = *args.After
+	}
+	for number := start.NumberU64(); number <= end.NumberU64(); number++ {
+		// The genesis block has no transactions nor rewards to trace
+		if number == 0 {
+			continue
+		}
+		block := start
+		if number != start.NumberU64() {
+			if block, err = api.api.blockByNumber(ctx, rpc.BlockNumber(number)); err != nil {
+				return nil, err
+			}
+		}
+		traces, err := api.traceBlock(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			var accounts traceAccounts
+			if err := json.Unmarshal(trace, &accounts); err != nil {
+				return nil, err
+			}
+			if !matches(fromSet, accounts.from()) || !matches(toSet, accounts.to()) {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			results = append(results, trace)
+			if args.Count != nil && uint64(len(results)) >= *args.Count {
+				return results, nil
+			}
+		}
+	}
+	return results, nil
+}
+
+// TraceResults is the result of a trace_replayTransaction request. Only the
+// call traces are supported, the state diff and VM traces are always empty.
+type TraceResults struct {
+	Output    hexutil.Bytes     `json:"output"`
+	StateDiff interface{}       `json:"stateDiff"`
+	Trace     []json.RawMessage `json:"trace"`
+	VmTrace   interface{}       `json:"vmTrace"`
+}
+
+// ReplayTransaction re-executes the given transaction and returns the requested
+// trace types. Only the "trace" type is supported.
+func (api *TraceAPI) ReplayTransaction(ctx context.Context, hash common.Hash, traceTypes []string) (*TraceResults, error) {
+	withTrace := false
+	for _, typ := range traceTypes {
+		switch typ {
+		case "trace":
+			withTrace = true
+		case "stateDiff", "vmTrace":
+			return nil, fmt.Errorf("trace type %q not supported", typ)
+		default:
+			return nil, fmt.Errorf("unknown trace type %q", typ)
+		}
+	}
+	traces, err := api.Transaction(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(traces) == 0 {
+		return nil, errors.New("transaction produced no traces")
+	}
+	var top traceAccounts
+	if err := json.Unmarshal(traces[0], &top); err != nil {
+		return nil, err
+	}
+	results := &TraceResults{Output: hexutil.Bytes{}}
+	if top.Result != nil && top.Result.Output != nil {
+		results.Output = top.Result.Output
+	}
+	if withTrace {
+		results.Trace = traces
+	}
+	return results, nil
+}
+
+// traceBlock returns the flattened traces of all the transactions in the block,
+// followed by the block reward traces if the consensus engine reports them.
+func (api *TraceAPI) traceBlock(ctx context.Context, block *types.Block) ([]json.RawMessage, error) {
+	tracer := flatCallTracer
+	results, err := api.api.traceBlock(ctx, block, &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return nil, err
+	}
+	traces := []json.RawMessage{}
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("tracing transaction %d failed: %s", i, result.Error)
+		}
+		var txTraces []json.RawMessage
+		if err := json.Unmarshal(result.Result.(json.RawMessage), &txTraces); err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	rewarder, ok := api.api.backend.Engine().(consensus.Rewarder)
+	if !ok {
+		return traces, nil
+	}
+	rewards, err := rewarder.BlockRewards(api.api.chainContext(ctx).(*chainContext), block.Header())
+	if err != nil {
+		return nil, err
+	}
+	for _, reward := range rewards {
+		trace, err := json.Marshal(&rewardTrace{
+			Action: rewardAction{
+				Author:     reward.Beneficiary,
+				RewardType: "block",
+				Value:      (*hexutil.Big)(reward.Amount),
+			},
+			BlockHash:    block.Hash(),
+			BlockNumber:  block.NumberU64(),
+			TraceAddress: []int{},
+			Type:         "reward",
+		})
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, trace)
+	}
+	return traces, nil
+}
@@ -165,7 +165,7 @@ func (ec *Client) getBlock(ctx context.Context, method string, args ...interface
 	txs := make([]*types.Transaction, len(body.Transactions))
 	for i, tx := range body.Transactions {
 		if tx.From != nil {
-			setSenderFromServer(tx.tx, *tx.From, body.Hash)
+			setSenderFromServer(tx.tx, *tx.From, tx.PrioritySender, tx.Sponsor, body.Hash)
 		}
 		txs[i] = tx.tx
 	}
@@ -199,9 +199,11 @@ type rpcTransaction struct {
 }
 
 type txExtraInfo struct {
-	BlockNumber *string         `json:"blockNumber,omitempty"`
-	BlockHash   *common.Hash    `json:"blockHash,omitempty"`
-	From        *common.Address `json:"from,omitempty"`
+	BlockNumber    *string           `json:"blockNumber,omitempty"`
+	BlockHash      *common.Hash      `json:"blockHash,omitempty"`
+	From           *common.Address   `json:"from,omitempty"`
+	PrioritySender *common.PublicKey `json:"prioritySender,omitempty"`
+	Sponsor        *common.Address   `json:"sponsor,omitempty"`
 }
 
 func (tx *rpcTransaction) UnmarshalJSON(msg []byte) error {
@@ -223,7 +225,7 @@ func (ec *Client) TransactionByHash(ctx context.Context, hash common.Hash) (tx *
 		return nil, false, fmt.Errorf("server returned transaction without signature")
 	}
 	if json.From != nil && json.BlockHash != nil {
-		setSenderFromServer(json.tx, *json.From, *json.BlockHash)
+		setSenderFromServer(json.tx, *json.From, json.PrioritySender, json.Sponsor, *json.BlockHash)
 	}
 	return json.tx, json.BlockNumber == nil, nil
 }
@@ -255,6 +257,102 @@ func (ec *Client) TransactionSender(ctx context.Context, tx *types.Transaction,
 	return meta.From, nil
 }
 
+// TransactionPrioritySender returns the priority key which authorized the given
+// priority transaction. The transaction must be known to the remote node and included
+// in the blockchain at the given block and index.
+//
+// There is a fast-path for transactions retrieved by TransactionByHash and
+// TransactionInBlock. Getting their priority key can be done without an RPC interaction.
+func (ec *Client) TransactionPrioritySender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.PublicKey, error) {
+	if tx.Type() != types.PriorityTxType {
+		return common.PublicKey{}, types.ErrTxIsNotPriorityType
+	}
+	// Try to load the key from the cache.
+	pubkey, err := types.PrioritySender(&senderFromServer{blockhash: block}, tx)
+	if err == nil {
+		return pubkey, nil
+	}
+
+	// It was not found in cache, ask the server.
+	var meta struct {
+		Hash           common.Hash
+		PrioritySender *common.PublicKey
+	}
+	if err = ec.c.CallContext(ctx, &meta, "eth_getTransactionByBlockHashAndIndex", block, hexutil.Uint64(index)); err != nil {
+		return common.PublicKey{}, err
+	}
+	if meta.Hash == (common.Hash{}) || meta.Hash != tx.Hash() {
+		return common.PublicKey{}, errors.New("wrong inclusion block/index")
+	}
+	if meta.PrioritySender == nil {
+		return common.PublicKey{}, errors.New("server did not report the priority key")
+	}
+	return *meta.PrioritySender, nil
+}
+
+// TransactionSponsor returns the address which paid the fee of the given sponsored
+// transaction. The transaction must be known to the remote node and included in the
+// blockchain at the given block and index.
+//
+// There is a fast-path for transactions retrieved by TransactionByHash and
+// TransactionInBlock. Getting their sponsor can be done without an RPC interaction.
+func (ec *Client) TransactionSponsor(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
+	if tx.Type() != types.SponsoredTxType {
+		return common.Address{}, types.ErrTxIsNotSponsoredType
+	}
+	// Try to load the sponsor from the cache.
+	sponsor, err := types.Sponsor(&senderFromServer{blockhash: block}, tx)
+	if err == nil {
+		return sponsor, nil
+	}
+
+	// It was not found in cache, ask the server.
+	var meta struct {
+		Hash    common.Hash
+		Sponsor *common.Address
+	}
+	if err = ec.c.CallContext(ctx, &meta, "eth_getTransactionByBlockHashAndIndex", block, hexutil.Uint64(index)); err != nil {
+		return common.Address{}, err
+	}
+	if meta.Hash == (common.Hash{}) || meta.Hash != tx.Hash() {
+		return common.Address{}, errors.New("wrong inclusion block/index")
+	}
+	if meta.Sponsor == nil {
+		return common.Address{}, errors.New("server did not report the sponsor")
+	}
+	return *meta.Sponsor, nil
+}
+
+// PriorityTransactors returns the priority transactors allowed at the given block.
+// The block number can be nil, in which case the transactors are read from the
+// latest known block.
+func (ec *Client) PriorityTransactors(ctx context.Context, blockNumber *big.Int) (common.PriorityTransactorMap, error) {
+	var result []struct {
+		PublicKey        common.PublicKey
+		EntityName       string
+		IsGasPriceWaiver bool
+		MaxPending       hexutil.Uint64
+		MaxBlockGas      hexutil.Uint64
+		MaxWindowGas     hexutil.Uint64
+	}
+	if err := ec.c.CallContext(ctx, &result, "eth_getPriorityTransactors", toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	transactors := make(common.PriorityTransactorMap, len(result))
+	for _, transactor := range result {
+		transactors[transactor.PublicKey] = common.PriorityTransactor{
+			IsGasPriceWaiver: transactor.IsGasPriceWaiver,
+			EntityName:       transactor.EntityName,
+			Limits: common.PriorityTransactorLimits{
+				MaxPending:   uint64(transactor.MaxPending),
+				MaxBlockGas:  uint64(transactor.MaxBlockGas),
+				MaxWindowGas: uint64(transactor.MaxWindowGas),
+			},
+		}
+	}
+	return transactors, nil
+}
+
 // TransactionCount returns the total number of transactions in the given block.
 func (ec *Client) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
 	var num hexutil.Uint
@@ -275,7 +373,7 @@ func (ec *Client) TransactionInBlock(ctx context.Context, blockHash common.Hash,
 		return nil, fmt.Errorf("server returned transaction without signature")
 	}
 	if json.From != nil && json.BlockHash != nil {
-		setSenderFromServer(json.tx, *json.From, *json.BlockHash)
+		setSenderFromServer(json.tx, *json.From, json.PrioritySender, json.Sponsor, *json.BlockHash)
 	}
 	return json.tx, err
 }
@@ -29,6 +29,7 @@ import (
 	electroneum "github.com/electroneum/electroneum-sc"
 	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
 	"github.com/electroneum/electroneum-sc/core/types"
@@ -185,11 +186,25 @@ var (
 	testKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
 	testAddr    = crypto.PubkeyToAddress(testKey.PublicKey)
 	testBalance = big.NewInt(2e15)
+
+	testPriorityKey, _  = crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+	testPriorityPubkey  = common.BytesToPublicKey(crypto.FromECDSAPub(&testPriorityKey.PublicKey))
+	testPriorityAddr    = common.HexToAddress("0x00000000000000000000000000000000000000ee")
+	testPriorityCode, _ = prioritytransactors.StubCode(common.PriorityTransactorMap{
+		testPriorityPubkey: {IsGasPriceWaiver: true, EntityName: "test"},
+	})
 )
 
 var genesis = &core.Genesis{
-	Config:    params.AllEthashProtocolChanges,
-	Alloc:     core.GenesisAlloc{testAddr: {Balance: testBalance}},
+	Config: func() *params.ChainConfig {
+		config := *params.AllEthashProtocolChanges
+		config.PriorityTransactorsContractAddress = testPriorityAddr
+		return &config
+	}(),
+	Alloc: core.GenesisAlloc{
+		testAddr:         {Balance: testBalance},
+		testPriorityAddr: {Balance: new(big.Int), Code: testPriorityCode},
+	},
 	ExtraData: []byte("test genesis"),
 	Timestamp: 9000,
 	BaseFee:   big.NewInt(params.InitialBaseFee),
@@ -211,6 +226,16 @@ var testTx2 = types.MustSignNewTx(testKey, types.LatestSigner(genesis.Config), &
 	To:       &common.Address{2},
 })
 
+var testTx3, _ = types.SignPriorityTx(types.NewTx(&types.PriorityTx{
+	ChainID:   genesis.Config.ChainID,
+	Nonce:     2,
+	GasTipCap: new(big.Int),
+	GasFeeCap: new(big.Int),
+	Gas:       params.TxGas,
+	To:        &common.Address{2},
+	Value:     big.NewInt(4),
+}), types.LatestSigner(genesis.Config), testKey, testPriorityKey)
+
 func newTestBackend(t *testing.T) (*node.Node, []*types.Block) {
 	// Generate test chain.
 	blocks := generateTestChain()
@@ -246,6 +271,7 @@ func generateTestChain() []*types.Block {
 			// Test transactions are included in block #2.
 			g.AddTx(testTx1)
 			g.AddTx(testTx2)
+			g.AddTx(testTx3)
 		}
 	}
 	gblock := genesis.ToBlock(db)
@@ -294,6 +320,9 @@ func TestEthClient(t *testing.T) {
 		"TransactionSender": {
 			func(t *testing.T) { testTransactionSender(t, client) },
 		},
+		"PriorityTransactions": {
+			func(t *testing.T) { testPriorityTransactions(t, client) },
+		},
 	}
 
 	t.Parallel()
@@ -669,6 +698,67 @@ func testTransactionSender(t *testing.T, client *rpc.Client) {
 	}
 }
 
+func testPriorityTransactions(t *testing.T, client *rpc.Client) {
+	ec := NewClient(client)
+	ctx := context.Background()
+
+	// Retrieve testTx3 via RPC.
+	block2, err := ec.HeaderByNumber(ctx, big.NewInt(2))
+	if err != nil {
+		t.Fatal("can't get block 2:", err)
+	}
+	tx3, err := ec.TransactionInBlock(ctx, block2.Hash(), 2)
+	if err != nil {
+		t.Fatal("can't get tx:", err)
+	}
+	if tx3.Hash() != testTx3.Hash() {
+		t.Fatalf("wrong tx hash %v, want %v", tx3.Hash(), testTx3.Hash())
+	}
+
+	// The priority key is cached in tx3, so no RPC should be required.
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pubkey, err := ec.TransactionPrioritySender(canceledCtx, tx3, block2.Hash(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pubkey != testPriorityPubkey {
+		t.Fatal("wrong priority key:", pubkey)
+	}
+
+	// The key of a transaction not fetched through RPC is queried from the server.
+	if pubkey, err = ec.TransactionPrioritySender(ctx, testTx3, block2.Hash(), 2); err != nil {
+		t.Fatal(err)
+	}
+	if pubkey != testPriorityPubkey {
+		t.Fatal("wrong priority key:", pubkey)
+	}
+	if _, err := ec.TransactionPrioritySender(ctx, testTx1, block2.Hash(), 0); err != types.ErrTxIsNotPriorityType {
+		t.Fatalf("error mismatch: have %v, want %v", err, types.ErrTxIsNotPriorityType)
+	}
+
+	// The transactor set is read from the contract, directly or by the server.
+	transactors, err := prioritytransactors.TransactorsAt(ctx, ec, testPriorityAddr, big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactors) != 1 || !transactors[testPriorityPubkey].IsGasPriceWaiver || transactors[testPriorityPubkey].EntityName != "test" {
+		t.Fatalf("wrong priority transactors: %v", transactors)
+	}
+	served, err := ec.PriorityTransactors(ctx, big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(served, transactors) {
+		t.Fatalf("priority transactors mismatch: have %v, want %v", served, transactors)
+	}
+	sub, err := client.EthSubscribe(ctx, make(chan interface{}), "priorityTransactors")
+	if err != nil {
+		t.Fatal("can't subscribe to priority transactor changes:", err)
+	}
+	sub.Unsubscribe()
+}
+
 func sendTransaction(ec *Client) error {
 	chainID, err := ec.ChainID(context.Background())
 	if err != nil {
@@ -24,19 +24,34 @@ import (
 	"github.com/electroneum/electroneum-sc/core/types"
 )
 
-// senderFromServer is a types.Signer that remembers the sender address returned by the RPC
-// server. It is stored in the transaction's sender address cache to avoid an additional
-// request in TransactionSender.
+// senderFromServer is a types.Signer that remembers the sender address, priority
+// key and sponsor returned by the RPC server. It is stored in the transaction's
+// sender caches to avoid additional requests in TransactionSender,
+// TransactionPrioritySender and TransactionSponsor.
 type senderFromServer struct {
 	addr      common.Address
+	pubkey    common.PublicKey
+	sponsor   common.Address
 	blockhash common.Hash
 }
 
 var errNotCached = errors.New("sender not cached")
 
-func setSenderFromServer(tx *types.Transaction, addr common.Address, block common.Hash) {
+func setSenderFromServer(tx *types.Transaction, addr common.Address, pubkey *common.PublicKey, sponsor *common.Address, block common.Hash) {
 	// Use types.Sender for side-effect to store our signer into the cache.
-	types.Sender(&senderFromServer{addr, block}, tx)
+	signer := &senderFromServer{addr: addr, blockhash: block}
+	types.Sender(signer, tx)
+
+	// Priority keys are only reported for priority transactions.
+	if pubkey != nil && tx.Type() == types.PriorityTxType {
+		signer.pubkey = *pubkey
+		types.PrioritySender(signer, tx)
+	}
+	// Sponsors are only reported for sponsored transactions.
+	if sponsor != nil && tx.Type() == types.SponsoredTxType {
+		signer.sponsor = *sponsor
+		types.Sponsor(signer, tx)
+	}
 }
 
 func (s *senderFromServer) Equal(other types.Signer) bool {
@@ -51,8 +66,18 @@ func (s *senderFromServer) Sender(tx *types.Transaction) (common.Address, error)
 	return s.addr, nil
 }
 
-func (s *senderFromServer) PrioritySender(tx *types.Transaction) (common.PublicKey, error) { // implement this later
-	return common.PublicKey{}, nil
+func (s *senderFromServer) PrioritySender(tx *types.Transaction) (common.PublicKey, error) {
+	if s.pubkey == (common.PublicKey{}) {
+		return common.PublicKey{}, errNotCached
+	}
+	return s.pubkey, nil
+}
+
+func (s *senderFromServer) Sponsor(tx *types.Transaction) (common.Address, error) {
+	if s.sponsor == (common.Address{}) {
+		return common.Address{}, errNotCached
+	}
+	return s.sponsor, nil
 }
 
 func (s *senderFromServer) ChainID() *big.Int {
@@ -64,6 +89,9 @@ func (s *senderFromServer) Hash(tx *types.Transaction) common.Hash {
 func (s *senderFromServer) PriorityHash(tx *types.Transaction) common.Hash {
 	panic("can't sign with senderFromServer")
 }
+func (s *senderFromServer) SponsorHash(tx *types.Transaction) common.Hash {
+	panic("can't sign with senderFromServer")
+}
 func (s *senderFromServer) SignatureValues(tx *types.Transaction, sig []byte) (R, S, V *big.Int, err error) {
 	panic("can't sign with senderFromServer")
 }
@@ -249,7 +249,7 @@ func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
 			}
 		}
 		return hexutil.Big(*tx.GasPrice()), nil
-	case types.PriorityTxType:
+	case types.PriorityTxType, types.SponsoredTxType:
 		if t.block != nil {
 			if baseFee, _ := t.block.BaseFeePerGas(ctx); baseFee != nil {
 				// price = min(gasTipCap + baseFee, gasFeeCap)
@@ -291,7 +291,7 @@ func (t *Transaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
 		return nil, nil
 	case types.DynamicFeeTxType:
 		return (*hexutil.Big)(tx.GasFeeCap()), nil
-	case types.PriorityTxType:
+	case types.PriorityTxType, types.SponsoredTxType:
 		return (*hexutil.Big)(tx.GasFeeCap()), nil
 	default:
 		return nil, nil
@@ -308,7 +308,7 @@ func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, e
 		return nil, nil
 	case types.DynamicFeeTxType:
 		return (*hexutil.Big)(tx.GasTipCap()), nil
-	case types.PriorityTxType:
+	case types.PriorityTxType, types.SponsoredTxType:
 		return (*hexutil.Big)(tx.GasTipCap()), nil
 	default:
 		return nil, nil
@@ -392,6 +392,23 @@ func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account,
 	}, nil
 }
 
+func (t *Transaction) Sponsor(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() != types.SponsoredTxType {
+		return nil, err
+	}
+	signer := types.LatestSigner(t.backend.ChainConfig())
+	sponsor, err := types.Sponsor(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		backend:       t.backend,
+		address:       sponsor,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
 func (t *Transaction) Block(ctx context.Context) (*Block, error) {
 	if _, err := t.resolve(ctx); err != nil {
 		return nil, err
@@ -1027,6 +1044,41 @@ func (b *Block) Account(ctx context.Context, args struct {
 	}, nil
 }
 
+// PriorityTransactor represents an entity allowed to send priority transactions.
+type PriorityTransactor struct {
+	transactor ethapi.RPCPriorityTransactor
+}
+
+func (p *PriorityTransactor) PublicKey() hexutil.Bytes {
+	return p.transactor.PublicKey.Bytes()
+}
+
+func (p *PriorityTransactor) EntityName() string {
+	return p.transactor.EntityName
+}
+
+func (p *PriorityTransactor) IsGasPriceWaiver() bool {
+	return p.transactor.IsGasPriceWaiver
+}
+
+func (b *Block) PriorityTransactors(ctx context.Context) ([]*PriorityTransactor, error) {
+	if b.numberOrHash == nil {
+		_, err := b.resolveHeader(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	transactors, err := ethapi.GetPriorityTransactors(ctx, b.backend, *b.numberOrHash)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*PriorityTransactor, len(transactors))
+	for i, transactor := range transactors {
+		result[i] = &PriorityTransactor{transactor: transactor}
+	}
+	return result, nil
+}
+
 // CallData encapsulates arguments to `call` or `estimateGas`.
 // All arguments are optional.
 type CallData struct {
@@ -87,6 +87,9 @@ const schema string = `
         # From is the account that sent this transaction - this will always be
         # an externally owned account.
         from(block: Long): Account!
+        # Sponsor is the account that paid the fee of a sponsored transaction.
+        # This is null for all other transaction types.
+        sponsor(block: Long): Account
         # To is the account the transaction was sent to. This is null for
         # contract-creating transactions.
         to(block: Long): Account
@@ -242,12 +245,25 @@ const schema string = `
         # EstimateGas estimates the amount of gas that will be required for
         # successful execution of a transaction at the current block's state.
         estimateGas(data: CallData!): Long!
+        # PriorityTransactors returns the priority transactors registered at
+        # the current block's state.
+        priorityTransactors: [PriorityTransactor!]!
         # RawHeader is the RLP encoding of the block's header.
         rawHeader: Bytes!
         # Raw is the RLP encoding of the block.
         raw: Bytes!
     }
 
+    # PriorityTransactor is an entity allowed to send priority transactions.
+    type PriorityTransactor {
+        # PublicKey is the uncompressed secp256k1 key signing the transactions.
+        publicKey: Bytes!
+        # EntityName is the name of the entity holding the key.
+        entityName: String!
+        # IsGasPriceWaiver is true if the transactions don't pay for gas.
+        isGasPriceWaiver: Boolean!
+    }
+
     # CallData represents the data associated with a local contract call.
     # All fields are optional.
     input CallData {
@@ -0,0 +1,260 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
+const chainHeadChanSize = 10
+
+// RPCPriorityTransactor represents a priority transactor as returned over RPC.
+type RPCPriorityTransactor struct {
+	PublicKey        common.PublicKey `json:"publicKey"`
+	EntityName       string           `json:"entityName"`
+	IsGasPriceWaiver bool             `json:"isGasPriceWaiver"`
+	MaxPending       hexutil.Uint64   `json:"maxPending"`
+	MaxBlockGas      hexutil.Uint64   `json:"maxBlockGas"`
+	MaxWindowGas     hexutil.Uint64   `json:"maxWindowGas"`
+}
+
+// RPCPriorityTransactorsChange is the notification sent when the set of priority
+// transactors changes.
+type RPCPriorityTransactorsChange struct {
+	BlockNumber hexutil.Uint64          `json:"blockNumber"`
+	BlockHash   common.Hash             `json:"blockHash"`
+	Transactors []RPCPriorityTransactor `json:"transactors"`
+}
+
+// priorityTransactors reads the priority transactors registered in the given state.
+func priorityTransactors(ctx context.Context, b Backend, state *state.StateDB, header *types.Header) (common.PriorityTransactorMap, error) {
+	msg := types.NewMessage(common.Address{}, nil, 0, new(big.Int), 0, new(big.Int), new(big.Int), new(big.Int), nil, nil, true, common.PublicKey{})
+	evm, _, err := b.GetEVM(ctx, msg, state, header, &vm.Config{NoBaseFee: true})
+	if err != nil {
+		return nil, err
+	}
+	return core.GetPriorityTransactors(evm), state.Error()
+}
+
+// priorityLookup returns a lookup into the priority transactors registered in
+// the state of the block with the given hash, evaluated in the context of
+// header. The transactors are only loaded on first use, which is limited to
+// resolving priority keys within the priority signature grace window.
+func priorityLookup(ctx context.Context, b Backend, hash common.Hash, header *types.Header) func(common.PublicKey) (common.PriorityTransactor, bool) {
+	return core.PriorityTransactorLookup(func() common.PriorityTransactorMap {
+		state, _, err := b.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithHash(hash, false))
+		if state == nil || err != nil {
+			return nil
+		}
+		transactors, _ := priorityTransactors(ctx, b, state, header)
+		return transactors
+	})
+}
+
+// newRPCPriorityTransactor returns a transactor in its RPC representation.
+func newRPCPriorityTransactor(pubkey common.PublicKey, transactor common.PriorityTransactor) RPCPriorityTransactor {
+	return RPCPriorityTransactor{
+		PublicKey:        pubkey,
+		EntityName:       transactor.EntityName,
+		IsGasPriceWaiver: transactor.IsGasPriceWaiver,
+		MaxPending:       hexutil.Uint64(transactor.Limits.MaxPending),
+		MaxBlockGas:      hexutil.Uint64(transactor.Limits.MaxBlockGas),
+		MaxWindowGas:     hexutil.Uint64(transactor.Limits.MaxWindowGas),
+	}
+}
+
+// newRPCPriorityTransactors returns the transactors in their RPC representation,
+// ordered by public key.
+func newRPCPriorityTransactors(transactors common.PriorityTransactorMap) []RPCPriorityTransactor {
+	result := make([]RPCPriorityTransactor, 0, len(transactors))
+	for pubkey, transactor := range transactors {
+		result = append(result, newRPCPriorityTransactor(pubkey, transactor))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].PublicKey[:], result[j].PublicKey[:]) < 0
+	})
+	return result
+}
+
+// GetPriorityTransactors returns the priority transactors registered in the
+// contract at the given block.
+func GetPriorityTransactors(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrHash) ([]RPCPriorityTransactor, error) {
+	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	transactors, err := priorityTransactors(ctx, b, state, header)
+	if err != nil {
+		return nil, err
+	}
+	return newRPCPriorityTransactors(transactors), nil
+}
+
+// GetPriorityTransactors returns the priority transactors registered in the
+// contract at the given block, along with their entity name and whether their
+// transactions are exempted from paying for gas.
+func (api *PublicBlockChainAPI) GetPriorityTransactors(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]RPCPriorityTransactor, error) {
+	return GetPriorityTransactors(ctx, api.b, blockNrOrHash)
+}
+
+// PriorityTransactors creates a subscription that is triggered each time a new
+// block changes the set of priority transactors.
+func (api *PublicBlockChainAPI) PriorityTransactors(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	// Start from the set of the current head
+	state, header, err := api.b.StateAndHeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	current, err := priorityTransactors(ctx, api.b, state, header)
+	if err != nil {
+		return nil, err
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		heads := make(chan core.ChainHeadEvent, chainHeadChanSize)
+		headSub := api.b.SubscribeChainHeadEvent(heads)
+		defer headSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-heads:
+				header := ev.Block.Header()
+				state, _, err := api.b.StateAndHeaderByNumberOrHash(context.Background(), rpc.BlockNumberOrHashWithHash(header.Hash(), false))
+				if state == nil || err != nil {
+					log.Debug("Failed to load state for priority transactors", "number", header.Number, "hash", header.Hash(), "err", err)
+					continue
+				}
+				transactors, err := priorityTransactors(context.Background(), api.b, state, header)
+				if err != nil {
+					log.Debug("Failed to read priority transactors", "number", header.Number, "hash", header.Hash(), "err", err)
+					continue
+				}
+				if equalPriorityTransactors(current, transactors) {
+					continue
+				}
+				current = transactors
+				notifier.Notify(rpcSub.ID, &RPCPriorityTransactorsChange{
+					BlockNumber: hexutil.Uint64(header.Number.Uint64()),
+					BlockHash:   header.Hash(),
+					Transactors: newRPCPriorityTransactors(transactors),
+				})
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			case <-headSub.Err():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// equalPriorityTransactors reports whether the two transactor sets are the same.
+func equalPriorityTransactors(a, b common.PriorityTransactorMap) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for pubkey, transactor := range a {
+		if other, ok := b[pubkey]; !ok || other != transactor {
+			return false
+		}
+	}
+	return true
+}
+
+// RPCPriorityMigration reports the pooled priority transactions against the
+// priority signature schemes valid before and after the future fork.
+type RPCPriorityMigration struct {
+	FutureForkBlock *hexutil.Big             `json:"futureForkBlock"`
+	GraceStart      *hexutil.Big             `json:"graceStart,omitempty"`
+	GraceEnd        *hexutil.Big             `json:"graceEnd,omitempty"`
+	Transactions    []RPCPriorityMigrationTx `json:"transactions"`
+}
+
+// RPCPriorityMigrationTx reports the priority signature schemes under which a
+// pooled priority transaction resolves to a known priority transactor. Affected
+// transactions are only valid under the pre-fork scheme and need their priority
+// signature renewed before the end of the grace window.
+type RPCPriorityMigrationTx struct {
+	Hash      common.Hash       `json:"hash"`
+	From      common.Address    `json:"from"`
+	Nonce     hexutil.Uint64    `json:"nonce"`
+	Pending   bool              `json:"pending"`
+	LegacyKey *common.PublicKey `json:"legacyKey"`
+	BoundKey  *common.PublicKey `json:"boundKey"`
+	Affected  bool              `json:"affected"`
+}
+
+// PriorityMigration reports which pooled priority transactions are signed under
+// the pre-fork priority signature scheme only, and so will be rejected after
+// the future fork and its priority signature grace window.
+func (s *PublicTxPoolAPI) PriorityMigration(ctx context.Context) (*RPCPriorityMigration, error) {
+	migrations, err := s.b.PriorityMigration()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		config     = s.b.ChainConfig()
+		signer     = types.LatestSigner(config)
+		start, end = config.PrioritySigGraceWindow()
+		report     = &RPCPriorityMigration{
+			FutureForkBlock: (*hexutil.Big)(config.FutureForkBlock),
+			GraceStart:      (*hexutil.Big)(start),
+			GraceEnd:        (*hexutil.Big)(end),
+			Transactions:    make([]RPCPriorityMigrationTx, 0, len(migrations)),
+		}
+	)
+	for _, migration := range migrations {
+		from, _ := types.Sender(signer, migration.Tx)
+		report.Transactions = append(report.Transactions, RPCPriorityMigrationTx{
+			Hash:      migration.Tx.Hash(),
+			From:      from,
+			Nonce:     hexutil.Uint64(migration.Tx.Nonce()),
+			Pending:   migration.Pending,
+			LegacyKey: migration.Legacy,
+			BoundKey:  migration.Bound,
+			Affected:  migration.Affected(),
+		})
+	}
+	sort.Slice(report.Transactions, func(i, j int) bool {
+		a, b := report.Transactions[i], report.Transactions[j]
+		if a.From != b.From {
+			return bytes.Compare(a.From[:], b.From[:]) < 0
+		}
+		return a.Nonce < b.Nonce
+	})
+	return report, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
+)
+
+// Tests that the priority sender of a transaction is resolved as in block
+// processing: against the transactors within the priority signature grace
+// window, and without looking them up outside of it.
+func TestRPCTransactionPrioritySender(t *testing.T) {
+	config := *params.TestChainConfig
+	config.FutureForkBlock = big.NewInt(1)
+	config.PrioritySigGraceBlocks = 2
+
+	var (
+		key, _         = crypto.GenerateKey()
+		priorityKey, _ = crypto.GenerateKey()
+		pubkey         = common.BytesToPublicKey(crypto.FromECDSAPub(&priorityKey.PublicKey))
+	)
+	// Priority sign under the pre-fork scheme
+	tx, err := types.SignNewPriorityTx(key, priorityKey, types.NewLondonSigner(config.ChainID), &types.PriorityTx{
+		ChainID:   config.ChainID,
+		GasTipCap: new(big.Int),
+		GasFeeCap: new(big.Int),
+		Gas:       21000,
+		To:        &common.Address{},
+	})
+	if err != nil {
+		t.Fatalf("failed to sign priority tx: %v", err)
+	}
+	lookups := 0
+	lookup := func(key common.PublicKey) (common.PriorityTransactor, bool) {
+		lookups++
+		return common.PriorityTransactor{IsGasPriceWaiver: true}, key == pubkey
+	}
+	// After the fork within the grace window the known pre-fork key is used
+	res := newRPCTransaction(tx, common.Hash{1}, 2, 0, big.NewInt(1), &config, lookup)
+	if res.PrioritySender == nil || *res.PrioritySender != pubkey {
+		t.Fatalf("grace window priority sender mismatch: have %x, want %x", res.PrioritySender, pubkey)
+	}
+	// Before the grace window the key only depends on the signer
+	lookups = 0
+	config.FutureForkBlock = big.NewInt(10)
+	if res := newRPCTransaction(tx, common.Hash{1}, 2, 0, big.NewInt(1), &config, lookup); res.PrioritySender == nil || *res.PrioritySender != pubkey {
+		t.Fatalf("pre-fork priority sender mismatch: have %x, want %x", res.PrioritySender, pubkey)
+	}
+	if lookups != 0 {
+		t.Fatalf("transactors looked up outside of the grace window: %d times", lookups)
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/electroneum/electroneum-sc/accounts/abi"
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/consensus/misc"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks a single simulation
+	// request can run.
+	maxSimulateBlocks = 256
+
+	// maxSimulateCalls is the maximum number of calls a single simulation
+	// request can run across all of its blocks.
+	maxSimulateCalls = 1000
+
+	// errCodeReverted is the JSON error code of a simulated call reverting.
+	errCodeReverted = 3
+
+	// errCodeVMError is the JSON error code of a simulated call failing in the
+	// EVM for any other reason than a revert.
+	errCodeVMError = -32015
+)
+
+// BlockOverrides is the set of header fields to override in a simulated block.
+type BlockOverrides struct {
+	Number       *hexutil.Big    `json:"number"`
+	Time         *hexutil.Uint64 `json:"time"`
+	GasLimit     *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient *common.Address `json:"feeRecipient"`
+	BaseFee      *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Apply overrides the fields of the given simulated header.
+func (diff *BlockOverrides) Apply(header *types.Header) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		header.Number = diff.Number.ToInt()
+	}
+	if diff.Time != nil {
+		header.Time = uint64(*diff.Time)
+	}
+	if diff.GasLimit != nil {
+		header.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.FeeRecipient != nil {
+		header.Coinbase = *diff.FeeRecipient
+	}
+	if diff.BaseFee != nil {
+		header.BaseFee = diff.BaseFee.ToInt()
+	}
+}
+
+// SimBlock is a simulated block: the overrides applied before it and the calls
+// executed in it.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *StateOverride    `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimCallResult is the outcome of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Logs       []*types.Log   `json:"logs"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *SimCallError  `json:"error,omitempty"`
+}
+
+// SimBlockResult is the outcome of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	StateRoot     common.Hash     `json:"stateRoot"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	FeeRecipient  common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// simulator runs a sequence of simulated blocks on top of a base state, carrying
+// the state changes of every call over to the next one.
+type simulator struct {
+	b        Backend
+	state    *state.StateDB
+	validate bool
+	gasCap   uint64
+}
+
+// SimulateV1 executes a sequence of simulated blocks, each with its own header
+// overrides, state overrides and calls, on top of the given block. The state
+// changes of every call are visible to the following ones, so multi-step flows
+// can be previewed as a whole. With validation enabled, the nonces, balances
+// and base fees are checked as for real transactions.
+func (api *PublicBlockChainAPI) SimulateV1(ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash) ([]*SimBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks: %d, limit %d", len(opts.BlockStateCalls), maxSimulateBlocks)
+	}
+	var calls int
+	for _, block := range opts.BlockStateCalls {
+		calls += len(block.Calls)
+	}
+	if calls > maxSimulateCalls {
+		return nil, fmt.Errorf("too many calls: %d, limit %d", calls, maxSimulateCalls)
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	state, parent, err := api.b.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	transactors, err := priorityTransactors(ctx, api.b, state, parent)
+	if err != nil {
+		return nil, err
+	}
+	state.SetPriorityTransactors(transactors)
+
+	// Bound the whole simulation by the RPC execution timeout
+	var cancel context.CancelFunc
+	if timeout := api.b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	sim := &simulator{
+		b:        api.b,
+		state:    state,
+		validate: opts.Validation,
+		gasCap:   api.b.RPCGasCap(),
+	}
+	results := make([]*SimBlockResult, 0, len(opts.BlockStateCalls))
+	for i, block := range opts.BlockStateCalls {
+		header, err := sim.header(parent, block.BlockOverrides)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		if err := block.StateOverrides.Apply(state); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		result, err := sim.processBlock(ctx, header, block.Calls)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		results = append(results, result)
+		parent = header
+	}
+	return results, nil
+}
+
+// header derives the header of the simulated block following parent, with the
+// given overrides applied.
+func (sim *simulator) header(parent *types.Header, overrides *BlockOverrides) (*types.Header, error) {
+	config := sim.b.ChainConfig()
+
+	period := uint64(1)
+	if config.IBFT != nil && config.IBFT.BlockPeriodSeconds > 0 {
+		period = config.IBFT.BlockPeriodSeconds
+	}
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   parent.Coinbase,
+		Difficulty: new(big.Int).Set(parent.Difficulty),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + period,
+	}
+	if config.IsLondon(header.Number) {
+		// Without validation calls run with zero fees, so don't charge them a
+		// base fee either unless one was requested explicitly
+		if sim.validate {
+			header.BaseFee = misc.CalcBaseFee(config, parent)
+		} else {
+			header.BaseFee = new(big.Int)
+		}
+	}
+	overrides.Apply(header)
+
+	if header.Number.Cmp(parent.Number) <= 0 {
+		return nil, fmt.Errorf("block number %v not above parent %v", header.Number, parent.Number)
+	}
+	if header.Time <= parent.Time {
+		return nil, fmt.Errorf("block timestamp %d not above parent %d", header.Time, parent.Time)
+	}
+	return header, nil
+}
+
+// processBlock executes the calls of a simulated block and seals its header.
+func (sim *simulator) processBlock(ctx context.Context, header *types.Header, calls []TransactionArgs) (*SimBlockResult, error) {
+	var (
+		config  = sim.b.ChainConfig()
+		gp      = new(core.GasPool).AddGas(header.GasLimit)
+		results = make([]SimCallResult, 0, len(calls))
+		logs    []*types.Log
+	)
+	for i, args := range calls {
+		result, callLogs, err := sim.processCall(ctx, header, gp, i, args)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		header.GasUsed += uint64(result.GasUsed)
+		results = append(results, *result)
+		logs = append(logs, callLogs...)
+	}
+	header.Root = sim.state.IntermediateRoot(config.IsEIP158(header.Number))
+
+	// The block hash is only known now, fill it and the log positions in
+	hash := header.Hash()
+	for i, log := range logs {
+		log.BlockHash = hash
+		log.BlockNumber = header.Number.Uint64()
+		log.Index = uint(i)
+	}
+	return &SimBlockResult{
+		Number:        hexutil.Uint64(header.Number.Uint64()),
+		Hash:          hash,
+		ParentHash:    header.ParentHash,
+		StateRoot:     header.Root,
+		Timestamp:     hexutil.Uint64(header.Time),
+		GasLimit:      hexutil.Uint64(header.GasLimit),
+		GasUsed:       hexutil.Uint64(header.GasUsed),
+		FeeRecipient:  header.Coinbase,
+		BaseFeePerGas: (*hexutil.Big)(header.BaseFee),
+		Calls:         results,
+	}, nil
+}
+
+// processCall executes a single call of a simulated block on the shared state.
+// Calls failing in the EVM are reported in the result, while calls which could
+// not be included in a block at all are returned as errors.
+func (sim *simulator) processCall(ctx context.Context, header *types.Header, gp *core.GasPool, index int, args TransactionArgs) (*SimCallResult, []*types.Log, error) {
+	from := args.from()
+	if args.Nonce == nil {
+		nonce := hexutil.Uint64(sim.state.GetNonce(from))
+		args.Nonce = &nonce
+	}
+	if args.Gas == nil {
+		gas := gp.Gas()
+		if sim.gasCap != 0 && sim.gasCap < gas {
+			gas = sim.gasCap
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	msg, err := args.ToMessage(sim.gasCap, header.BaseFee)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sim.validate {
+		// Rebuild the message as a real transaction to have its nonce checked
+		msg = types.NewMessage(msg.From(), msg.To(), uint64(*args.Nonce), msg.Value(), msg.Gas(), msg.GasPrice(), msg.GasFeeCap(), msg.GasTipCap(), msg.Data(), msg.AccessList(), false, msg.PrioritySender())
+	}
+	// Logs are collected under the hash of the call as an unsigned transaction
+	txHash := args.ToTransaction().Hash()
+	sim.state.Prepare(txHash, index)
+
+	evm, vmError, err := sim.b.GetEVM(ctx, msg, sim.state, header, &vm.Config{NoBaseFee: !sim.validate})
+	if err != nil {
+		return nil, nil, err
+	}
+	// The simulated header isn't sealed, so the fee recipient can't be derived
+	evm.Context.Coinbase = header.Coinbase
+
+	// Abort the call once the simulation times out, without outliving the call
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		evm.Cancel()
+	}()
+	result, err := core.ApplyMessage(evm, msg, gp)
+	if err := vmError(); err != nil {
+		return nil, nil, err
+	}
+	if evm.Cancelled() {
+		return nil, nil, errors.New("execution aborted (timeout)")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	config := sim.b.ChainConfig()
+	sim.state.Finalise(config.IsEIP158(header.Number))
+
+	// Later calls see the priority transactors updated by this one
+	if msg.To() != nil && *msg.To() == config.GetPriorityTransactorsContractAddress(header.Number) {
+		sim.state.SetPriorityTransactors(core.GetPriorityTransactors(evm))
+	}
+
+	logs := sim.state.GetLogs(txHash, common.Hash{})
+	if logs == nil {
+		logs = []*types.Log{}
+	}
+	call := &SimCallResult{
+		ReturnData: common.CopyBytes(result.Return()),
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(result.UsedGas),
+		Status:     hexutil.Uint64(types.ReceiptStatusSuccessful),
+	}
+	if result.Failed() {
+		call.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+		call.Error = &SimCallError{Message: result.Err.Error(), Code: errCodeVMError}
+		if errors.Is(result.Err, vm.ErrExecutionReverted) {
+			call.ReturnData = common.CopyBytes(result.Revert())
+			call.Error.Code = errCodeReverted
+			call.Error.Data = hexutil.Encode(result.Revert())
+			if reason, err := abi.UnpackRevert(result.Revert()); err == nil {
+				call.Error.Message = fmt.Sprintf("execution reverted: %v", reason)
+			}
+		}
+	}
+	return call, logs, nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+var (
+	simFunded = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	simEmpty  = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	simOther  = common.HexToAddress("0x1000000000000000000000000000000000000003")
+
+	// simNumberCode and simCoinbaseCode return the number and the fee
+	// recipient of the block they run in.
+	simNumberCode   = hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	simCoinbaseCode = hexutil.Bytes(common.FromHex("0x4160005260206000f3"))
+)
+
+// simBackend is the part of the backend needed by the simulation, on top of a
+// chain made of its genesis block.
+type simBackend struct {
+	Backend
+	chain *core.BlockChain
+}
+
+func newSimBackend(t *testing.T) *simBackend {
+	db := rawdb.NewMemoryDatabase()
+	gspec := &core.Genesis{
+		Config:   params.TestChainConfig,
+		GasLimit: 30_000_000,
+		BaseFee:  big.NewInt(params.InitialBaseFee),
+		Alloc:    core.GenesisAlloc{simFunded: {Balance: big.NewInt(params.Ether)}},
+	}
+	gspec.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	t.Cleanup(chain.Stop)
+	return &simBackend{chain: chain}
+}
+
+func (b *simBackend) ChainConfig() *params.ChainConfig { return b.chain.Config() }
+func (b *simBackend) RPCGasCap() uint64                { return 50_000_000 }
+func (b *simBackend) RPCEVMTimeout() time.Duration     { return 5 * time.Second }
+
+func (b *simBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	header := b.chain.CurrentHeader()
+	statedb, err := b.chain.StateAt(header.Root)
+	return statedb, header, err
+}
+
+func (b *simBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header, vmConfig *vm.Config) (*vm.EVM, func() error, error) {
+	context := core.NewEVMBlockContext(header, b.chain, nil)
+	return vm.NewEVM(context, core.NewEVMTxContext(msg), state, b.chain.Config(), *vmConfig), func() error { return nil }, nil
+}
+
+func simulate(t *testing.T, opts SimOpts) ([]*SimBlockResult, error) {
+	return NewPublicBlockChainAPI(newSimBackend(t)).SimulateV1(context.Background(), opts, nil)
+}
+
+func simBalance(amount int64) **hexutil.Big {
+	balance := (*hexutil.Big)(big.NewInt(amount))
+	return &balance
+}
+
+func simValue(amount int64) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(amount))
+}
+
+// Tests that the header fields of a simulated block can be overridden, and that
+// the calls of the block see them.
+func TestSimulateV1BlockOverrides(t *testing.T) {
+	var (
+		numberAddr   = common.HexToAddress("0xc1")
+		coinbaseAddr = common.HexToAddress("0xc2")
+		number       = hexutil.Big(*big.NewInt(10))
+		timestamp    = hexutil.Uint64(1000)
+	)
+	results, err := simulate(t, SimOpts{BlockStateCalls: []SimBlock{{
+		BlockOverrides: &BlockOverrides{Number: &number, Time: &timestamp, FeeRecipient: &simOther},
+		StateOverrides: &StateOverride{
+			numberAddr:   {Code: &simNumberCode},
+			coinbaseAddr: {Code: &simCoinbaseCode},
+		},
+		Calls: []TransactionArgs{{From: &simFunded, To: &numberAddr}, {From: &simFunded, To: &coinbaseAddr}},
+	}}})
+	if err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	block := results[0]
+	if block.Number != 10 || block.Timestamp != timestamp || block.FeeRecipient != simOther {
+		t.Fatalf("header overrides not applied: number %d, time %d, fee recipient %x", block.Number, block.Timestamp, block.FeeRecipient)
+	}
+	if have := new(big.Int).SetBytes(block.Calls[0].ReturnData); have.Uint64() != 10 {
+		t.Errorf("call block number mismatch: have %v, want %v", have, 10)
+	}
+	if have := common.BytesToAddress(block.Calls[1].ReturnData); have != simOther {
+		t.Errorf("call fee recipient mismatch: have %x, want %x", have, simOther)
+	}
+	// Blocks can't go back in number
+	zero := hexutil.Big(*new(big.Int))
+	if _, err := simulate(t, SimOpts{BlockStateCalls: []SimBlock{{BlockOverrides: &BlockOverrides{Number: &zero}}}}); err == nil {
+		t.Fatal("block number below parent accepted")
+	}
+}
+
+// Tests that state overrides apply to the calls of their block and the ones after.
+func TestSimulateV1StateOverrides(t *testing.T) {
+	transfer := TransactionArgs{From: &simEmpty, To: &simOther, Value: simValue(1000)}
+
+	if _, err := simulate(t, SimOpts{BlockStateCalls: []SimBlock{{Calls: []TransactionArgs{transfer}}}}); err == nil {
+		t.Fatal("transfer from an empty account accepted")
+	}
+	results, err := simulate(t, SimOpts{BlockStateCalls: []SimBlock{
+		{StateOverrides: &StateOverride{simEmpty: {Balance: simBalance(1500)}}, Calls: []TransactionArgs{transfer}},
+		{Calls: []TransactionArgs{{From: &simEmpty, To: &simOther, Value: simValue(500)}}},
+	}})
+	if err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	for i, block := range results {
+		if status := block.Calls[0].Status; status != hexutil.Uint64(types.ReceiptStatusSuccessful) {
+			t.Errorf("block %d: call status mismatch: have %d, want %d", i, status, types.ReceiptStatusSuccessful)
+		}
+	}
+	// The overridden balance is spent by now
+	_, err = simulate(t, SimOpts{BlockStateCalls: []SimBlock{
+		{StateOverrides: &StateOverride{simEmpty: {Balance: simBalance(1500)}}, Calls: []TransactionArgs{transfer}},
+		{Calls: []TransactionArgs{transfer}},
+	}})
+	if err == nil {
+		t.Fatal("transfer over the overridden balance accepted")
+	}
+}
+
+// Tests that nonces and base fees are only enforced with validation enabled.
+func TestSimulateV1Validation(t *testing.T) {
+	var (
+		nonce  = hexutil.Uint64(5)
+		feeCap = simValue(params.GWei * 10)
+	)
+	call := TransactionArgs{From: &simFunded, To: &simOther, Nonce: &nonce}
+
+	results, err := simulate(t, SimOpts{BlockStateCalls: []SimBlock{{Calls: []TransactionArgs{call}}}})
+	if err != nil {
+		t.Fatalf("failed to simulate without validation: %v", err)
+	}
+	if fee := results[0].BaseFeePerGas; fee == nil || fee.ToInt().Sign() != 0 {
+		t.Errorf("base fee without validation mismatch: have %v, want 0", fee)
+	}
+	_, err = simulate(t, SimOpts{Validation: true, BlockStateCalls: []SimBlock{{Calls: []TransactionArgs{call}}}})
+	if err == nil || !strings.Contains(err.Error(), core.ErrNonceTooHigh.Error()) {
+		t.Fatalf("nonce error mismatch: have %v, want %v", err, core.ErrNonceTooHigh)
+	}
+	// Without fees, calls can't pay the base fee of a validated block
+	call.Nonce = nil
+	_, err = simulate(t, SimOpts{Validation: true, BlockStateCalls: []SimBlock{{Calls: []TransactionArgs{call}}}})
+	if err == nil || !strings.Contains(err.Error(), core.ErrFeeCapTooLow.Error()) {
+		t.Fatalf("fee error mismatch: have %v, want %v", err, core.ErrFeeCapTooLow)
+	}
+	call.MaxFeePerGas = feeCap
+	results, err = simulate(t, SimOpts{Validation: true, BlockStateCalls: []SimBlock{{Calls: []TransactionArgs{call}}}})
+	if err != nil {
+		t.Fatalf("failed to simulate with validation: %v", err)
+	}
+	if fee := results[0].BaseFeePerGas; fee == nil || fee.ToInt().Sign() == 0 {
+		t.Errorf("base fee with validation mismatch: have %v, want non-zero", fee)
+	}
+}
+
+// Tests that simulated blocks are chained on top of each other and see the state
+// changes of the blocks before them.
+func TestSimulateV1ChainedBlocks(t *testing.T) {
+	results, err := simulate(t, SimOpts{BlockStateCalls: []SimBlock{
+		{Calls: []TransactionArgs{{From: &simFunded, To: &simEmpty, Value: simValue(1000)}}},
+		{Calls: []TransactionArgs{{From: &simEmpty, To: &simOther, Value: simValue(600)}}},
+		{},
+	}})
+	if err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	for i, block := range results {
+		if block.Number != hexutil.Uint64(i+1) {
+			t.Errorf("block %d: number mismatch: have %d, want %d", i, block.Number, i+1)
+		}
+		if i > 0 && block.ParentHash != results[i-1].Hash {
+			t.Errorf("block %d: parent hash mismatch: have %x, want %x", i, block.ParentHash, results[i-1].Hash)
+		}
+		if i > 0 && block.Timestamp <= results[i-1].Timestamp {
+			t.Errorf("block %d: timestamp %d not above parent %d", i, block.Timestamp, results[i-1].Timestamp)
+		}
+	}
+	if used := results[1].GasUsed; used != hexutil.Uint64(params.TxGas) {
+		t.Errorf("gas used mismatch: have %d, want %d", used, params.TxGas)
+	}
+	if root := results[2].StateRoot; root != results[1].StateRoot {
+		t.Errorf("empty block changed the state root: have %x, want %x", root, results[1].StateRoot)
+	}
+}
+
+// Tests that simulation requests are bounded in blocks and calls.
+func TestSimulateV1Limits(t *testing.T) {
+	if _, err := simulate(t, SimOpts{}); err == nil {
+		t.Error("empty simulation accepted")
+	}
+	if _, err := simulate(t, SimOpts{BlockStateCalls: make([]SimBlock, maxSimulateBlocks+1)}); err == nil {
+		t.Error("simulation over the block limit accepted")
+	}
+	blocks := []SimBlock{{Calls: make([]TransactionArgs, maxSimulateCalls/2)}, {Calls: make([]TransactionArgs, maxSimulateCalls/2+1)}}
+	if _, err := simulate(t, SimOpts{BlockStateCalls: blocks}); err == nil || !strings.Contains(err.Error(), "too many calls") {
+		t.Errorf("call limit error mismatch: have %v", err)
+	}
+}
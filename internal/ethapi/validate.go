@@ -0,0 +1,265 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/electroneum/electroneum-sc/accounts/abi"
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+// RPCTxValidation is the report of txpool_validate. Error is the reason the
+// transaction would be refused, empty if it would be accepted.
+type RPCTxValidation struct {
+	Hash  common.Hash    `json:"hash"`
+	Type  hexutil.Uint64 `json:"type"`
+	Valid bool           `json:"valid"`
+	Error string         `json:"error,omitempty"`
+	Known bool           `json:"known"`
+
+	// RPCError is set if the transaction is refused by the RPC submission checks
+	RPCError string `json:"rpcError,omitempty"`
+
+	Sender      *common.Address `json:"sender,omitempty"`
+	SenderError string          `json:"senderError,omitempty"`
+
+	PrioritySigner     string                 `json:"prioritySigner,omitempty"`
+	PriorityPubkey     *common.PublicKey      `json:"priorityPubkey,omitempty"`
+	PriorityError      string                 `json:"priorityError,omitempty"`
+	PriorityTransactor *RPCPriorityTransactor `json:"priorityTransactor,omitempty"`
+	GasPriceWaiver     bool                   `json:"gasPriceWaiver"`
+	QuotaError         string                 `json:"quotaError,omitempty"`
+
+	Sponsor      *common.Address `json:"sponsor,omitempty"`
+	SponsorError string          `json:"sponsorError,omitempty"`
+
+	Fees         RPCTxFeeCheck          `json:"fees"`
+	Nonce        RPCTxNonceCheck        `json:"nonce"`
+	Balance      RPCTxBalanceCheck      `json:"balance"`
+	IntrinsicGas RPCTxIntrinsicGasCheck `json:"intrinsicGas"`
+	Execution    *RPCTxExecution        `json:"execution,omitempty"`
+}
+
+// RPCTxFeeCheck reports the fee fields of a transaction and the limits they
+// are checked against.
+type RPCTxFeeCheck struct {
+	GasFeeCap *hexutil.Big `json:"maxFeePerGas"`
+	GasTipCap *hexutil.Big `json:"maxPriorityFeePerGas"`
+	BaseFee   *hexutil.Big `json:"baseFee,omitempty"`
+	MinTip    *hexutil.Big `json:"minTip"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// RPCTxNonceCheck reports the nonce of a transaction against the state and
+// pool nonces of its sender. Gap is the number of transactions missing before
+// the transaction can be executed.
+type RPCTxNonceCheck struct {
+	Nonce        hexutil.Uint64 `json:"nonce"`
+	StateNonce   hexutil.Uint64 `json:"stateNonce"`
+	PendingNonce hexutil.Uint64 `json:"pendingNonce"`
+	Gap          hexutil.Uint64 `json:"gap"`
+}
+
+// RPCTxBalanceCheck reports the balance of the sender against the cost of the
+// transaction charged to it.
+type RPCTxBalanceCheck struct {
+	Balance    *hexutil.Big `json:"balance"`
+	Cost       *hexutil.Big `json:"cost"`
+	Sufficient bool         `json:"sufficient"`
+}
+
+// RPCTxIntrinsicGasCheck reports the gas limit of a transaction against the
+// intrinsic gas it needs.
+type RPCTxIntrinsicGasCheck struct {
+	Gas      hexutil.Uint64 `json:"gas"`
+	Required hexutil.Uint64 `json:"required"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// RPCTxExecution reports the outcome of executing a transaction on top of the
+// pending block.
+type RPCTxExecution struct {
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Error        string         `json:"error,omitempty"`
+	RevertReason string         `json:"revertReason,omitempty"`
+	ReturnData   hexutil.Bytes  `json:"returnData,omitempty"`
+}
+
+// errorString returns the message of err, or an empty string if it is nil.
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// Validate runs every check eth_sendRawTransaction and the transaction pool
+// would run against the given signed transaction, and executes it on top of
+// the pending block, without submitting it.
+func (s *PublicTxPoolAPI) Validate(ctx context.Context, input hexutil.Bytes) (*RPCTxValidation, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return nil, err
+	}
+	validation, err := s.b.ValidateTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	report := &RPCTxValidation{
+		Hash:        tx.Hash(),
+		Type:        hexutil.Uint64(tx.Type()),
+		Known:       validation.Known,
+		SenderError: errorString(validation.SenderErr),
+		Fees: RPCTxFeeCheck{
+			GasFeeCap: (*hexutil.Big)(tx.GasFeeCap()),
+			GasTipCap: (*hexutil.Big)(tx.GasTipCap()),
+			BaseFee:   (*hexutil.Big)(validation.BaseFee),
+			MinTip:    (*hexutil.Big)(validation.MinTip),
+			Error:     errorString(validation.FeeErr),
+		},
+		Nonce: RPCTxNonceCheck{
+			Nonce:        hexutil.Uint64(tx.Nonce()),
+			StateNonce:   hexutil.Uint64(validation.StateNonce),
+			PendingNonce: hexutil.Uint64(validation.PendingNonce),
+		},
+		IntrinsicGas: RPCTxIntrinsicGasCheck{
+			Gas:      hexutil.Uint64(tx.Gas()),
+			Required: hexutil.Uint64(validation.IntrinsicGas),
+			Error:    errorString(validation.IntrinsicErr),
+		},
+	}
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+		report.RPCError = err.Error()
+	} else if !s.b.UnprotectedAllowed() && !tx.Protected() {
+		report.RPCError = "only replay-protected (EIP-155) transactions allowed over RPC"
+	}
+	if validation.SenderErr == nil {
+		report.Sender = &validation.Sender
+		if tx.Nonce() > validation.PendingNonce {
+			report.Nonce.Gap = hexutil.Uint64(tx.Nonce() - validation.PendingNonce)
+		}
+		report.Balance = RPCTxBalanceCheck{
+			Balance:    (*hexutil.Big)(validation.Balance),
+			Cost:       (*hexutil.Big)(validation.Cost),
+			Sufficient: validation.Balance.Cmp(validation.Cost) >= 0,
+		}
+	}
+	if tx.Type() == types.PriorityTxType {
+		report.PrioritySigner = validation.PrioritySigner
+		report.PriorityPubkey = validation.PriorityPubkey
+		report.PriorityError = errorString(validation.PriorityErr)
+		report.QuotaError = errorString(validation.QuotaErr)
+		if transactor := validation.Transactor; transactor != nil {
+			rpcTransactor := newRPCPriorityTransactor(*validation.PriorityPubkey, *transactor)
+			report.PriorityTransactor = &rpcTransactor
+			report.GasPriceWaiver = transactor.IsGasPriceWaiver
+		}
+	}
+	if tx.Type() == types.SponsoredTxType {
+		report.Sponsor = validation.Sponsor
+		report.SponsorError = errorString(validation.SponsorErr)
+	}
+	// Execute the transaction if it got far enough to have a sender
+	if validation.SenderErr == nil {
+		report.Execution, err = executeTx(ctx, s.b, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case report.RPCError != "":
+		report.Error = report.RPCError
+	case validation.Err != nil:
+		report.Error = validation.Err.Error()
+	}
+	report.Valid = report.Error == ""
+	return report, nil
+}
+
+// executeTx applies the transaction on top of the pending block, with the
+// priority transactors of that block, and reports the outcome. The execution is
+// bounded by the RPC gas cap and EVM timeout, as calls are.
+func executeTx(ctx context.Context, b Backend, tx *types.Transaction) (*RPCTxExecution, error) {
+	state, header, err := b.StateAndHeaderByNumber(ctx, rpc.PendingBlockNumber)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	state = state.Copy()
+	transactors, err := priorityTransactors(ctx, b, state, header)
+	if err != nil {
+		return nil, err
+	}
+	state.SetPriorityTransactors(transactors)
+
+	msg, err := core.TransactionToMessage(b.ChainConfig(), header, tx, state.GetPriorityTransactorByKey)
+	if err != nil {
+		return &RPCTxExecution{Error: err.Error()}, nil
+	}
+	if gasCap := b.RPCGasCap(); gasCap != 0 && msg.Gas() > gasCap {
+		log.Warn("Caller gas above allowance, capping", "requested", msg.Gas(), "cap", gasCap)
+		msg = msg.WithGas(gasCap)
+	}
+	// Setup context so it may be cancelled when the execution has completed or
+	// timed out
+	var cancel context.CancelFunc
+	timeout := b.RPCEVMTimeout()
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	evm, vmError, err := b.GetEVM(ctx, msg, state, header, &vm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		evm.Cancel()
+	}()
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(header.GasLimit))
+	if err := vmError(); err != nil {
+		return nil, err
+	}
+	if evm.Cancelled() {
+		return nil, fmt.Errorf("execution aborted (timeout = %v)", timeout)
+	}
+	if err != nil {
+		return &RPCTxExecution{Error: err.Error()}, nil
+	}
+	execution := &RPCTxExecution{
+		GasUsed:    hexutil.Uint64(result.UsedGas),
+		Error:      errorString(result.Err),
+		ReturnData: common.CopyBytes(result.ReturnData),
+	}
+	if errors.Is(result.Err, vm.ErrExecutionReverted) {
+		if reason, err := abi.UnpackRevert(result.Revert()); err == nil {
+			execution.RevertReason = reason
+		}
+	}
+	return execution, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/core"
+	"github.com/electroneum/electroneum-sc/core/rawdb"
+	"github.com/electroneum/electroneum-sc/core/state"
+	"github.com/electroneum/electroneum-sc/core/types"
+	"github.com/electroneum/electroneum-sc/core/vm"
+	"github.com/electroneum/electroneum-sc/crypto"
+	"github.com/electroneum/electroneum-sc/params"
+	"github.com/electroneum/electroneum-sc/rpc"
+)
+
+// execBackend is the part of the backend needed to execute a transaction on top
+// of the pending block, with configurable RPC bounds.
+type execBackend struct {
+	*simBackend
+	gasCap  uint64
+	timeout time.Duration
+}
+
+func (b *execBackend) RPCGasCap() uint64            { return b.gasCap }
+func (b *execBackend) RPCEVMTimeout() time.Duration { return b.timeout }
+
+func (b *execBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
+	return b.StateAndHeaderByNumberOrHash(ctx, rpc.BlockNumberOrHashWithNumber(number))
+}
+
+// Tests that executing a transaction for validation is bounded by the RPC gas
+// cap and EVM timeout.
+func TestExecuteTxBounds(t *testing.T) {
+	var (
+		key, _ = crypto.GenerateKey()
+		loop   = common.HexToAddress("0xc1")
+		db     = rawdb.NewMemoryDatabase()
+		gspec  = &core.Genesis{
+			Config:   params.TestChainConfig,
+			GasLimit: 30_000_000,
+			BaseFee:  big.NewInt(params.InitialBaseFee),
+			Alloc: core.GenesisAlloc{
+				crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.Ether)},
+				loop:                                  {Code: common.FromHex("0x5b600056"), Balance: new(big.Int)}, // JUMPDEST PUSH1 0 JUMP
+			},
+		}
+	)
+	gspec.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	tx, _ := types.SignTx(types.NewTransaction(0, loop, new(big.Int), 20_000_000, big.NewInt(2*params.InitialBaseFee), nil), types.LatestSigner(gspec.Config), key)
+
+	// The gas above the cap is not spent
+	execution, err := executeTx(context.Background(), &execBackend{simBackend: &simBackend{chain: chain}, gasCap: 100_000}, tx)
+	if err != nil {
+		t.Fatalf("failed to execute transaction: %v", err)
+	}
+	if execution.GasUsed != 100_000 || execution.Error == "" {
+		t.Errorf("capped execution mismatch: have %+v", execution)
+	}
+	// The execution is aborted past the timeout
+	_, err = executeTx(context.Background(), &execBackend{simBackend: &simBackend{chain: chain}, timeout: time.Millisecond}, tx)
+	if err == nil || !strings.Contains(err.Error(), "execution aborted") {
+		t.Errorf("timed out execution mismatch: have %v", err)
+	}
+}
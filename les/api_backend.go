@@ -227,6 +227,14 @@ func (b *LesApiBackend) TxPoolContentFrom(addr common.Address) (types.Transactio
 	return b.eth.txPool.ContentFrom(addr)
 }
 
+func (b *LesApiBackend) ValidateTx(tx *types.Transaction) (*core.TxValidation, error) {
+	return nil, errors.New("transaction validation is not supported by light clients")
+}
+
+func (b *LesApiBackend) PriorityMigration() ([]*core.PriorityMigration, error) {
+	return nil, errors.New("priority signature migration is not supported by light clients")
+}
+
 func (b *LesApiBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return b.eth.txPool.SubscribeNewTxsEvent(ch)
 }
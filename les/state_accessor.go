@@ -52,12 +52,13 @@ func (leth *LightEthereum) stateAtTransaction(ctx context.Context, block *types.
 		return nil, vm.BlockContext{}, statedb, nil
 	}
 	// Recompute transactions up to the target index.
-	signer := types.MakeSigner(leth.blockchain.Config(), block.Number())
+	header := block.Header()
+	context := core.NewEVMBlockContext(header, leth.blockchain, nil)
+	statedb.SetPriorityTransactors(core.GetPriorityTransactors(vm.NewEVM(context, vm.TxContext{}, statedb, leth.blockchain.Config(), vm.Config{})))
 	for idx, tx := range block.Transactions() {
 		// Assemble the transaction call message and return if the requested offset
-		msg, _ := tx.AsMessage(signer, block.BaseFee())
+		msg, _ := core.TransactionToMessage(leth.blockchain.Config(), header, tx, statedb.GetPriorityTransactorByKey)
 		txContext := core.NewEVMTxContext(msg)
-		context := core.NewEVMBlockContext(block.Header(), leth.blockchain, nil)
 		statedb.Prepare(tx.Hash(), idx)
 		if idx == txIndex {
 			return msg, context, statedb, nil
@@ -67,6 +68,7 @@ func (leth *LightEthereum) stateAtTransaction(ctx context.Context, block *types.
 		if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(tx.Gas())); err != nil {
 			return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction %#x failed: %v", tx.Hash(), err)
 		}
+		core.RefreshPriorityTransactors(vmenv, msg)
 		// Ensure any modifications are committed to the state
 		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
 		statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
@@ -377,10 +377,20 @@ func (pool *TxPool) validateTx(ctx context.Context, tx *types.Transaction) error
 	}
 
 	// Transactor should have enough funds to cover the costs
-	// cost == V + GP * GL
+	// cost == V + GP * GL, or V alone for sponsored transactions
 	if b := currentState.GetBalance(from); b.Cmp(tx.Cost()) < 0 {
 		return core.ErrInsufficientFunds
 	}
+	// Sponsor should have enough funds to cover the gas
+	if tx.Type() == types.SponsoredTxType {
+		sponsor, err := types.Sponsor(pool.signer, tx)
+		if err != nil {
+			return core.ErrInvalidSponsor
+		}
+		if b := currentState.GetBalance(sponsor); b.Cmp(tx.SponsorCost()) < 0 {
+			return core.ErrInsufficientSponsorFunds
+		}
+	}
 
 	// Should supply enough intrinsic gas
 	gas, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, pool.istanbul)
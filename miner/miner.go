@@ -55,6 +55,8 @@ type Config struct {
 	Recommit              time.Duration  // The time interval for miner to re-create mining work.
 	Noverify              bool           // Disable remote mining solution verification(only useful in ethash).
 	PrioritiseElectroneum bool           // Prioritise Electroneum Ltd transactions when filling blocks?
+
+	PriorityQuota core.PriorityQuotaConfig // Limits on the gas price waived transactions of each priority transactor
 }
 
 // Miner creates blocks and searches for proof-of-work values.
@@ -96,6 +96,9 @@ type environment struct {
 	coinbase              common.Address
 	prioritiseElectroneum bool // prioritise electroneum's transactions over others?
 
+	priorityGas       map[common.PublicKey]uint64 // Waived gas per priority transactor included in this block
+	priorityWindowGas map[common.PublicKey]uint64 // Waived gas per priority transactor in the preceding window blocks
+
 	header   *types.Header
 	txs      []*types.Transaction
 	receipts []*types.Receipt
@@ -114,6 +117,11 @@ func (env *environment) copy() *environment {
 		prioritiseElectroneum: env.prioritiseElectroneum,
 		header:                types.CopyHeader(env.header),
 		receipts:              copyReceipts(env.receipts),
+		priorityGas:           make(map[common.PublicKey]uint64, len(env.priorityGas)),
+		priorityWindowGas:     env.priorityWindowGas,
+	}
+	for pubkey, gas := range env.priorityGas {
+		cpy.priorityGas[pubkey] = gas
 	}
 	if env.gasPool != nil {
 		gasPool := *env.gasPool
@@ -192,6 +200,8 @@ type worker struct {
 	eth         Backend
 	chain       *core.BlockChain
 
+	priorityGas *core.PriorityGasTracker // Waived gas of recent blocks per priority transactor
+
 	// Feeds
 	pendingLogsFeed event.Feed
 
@@ -268,6 +278,7 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		eth:                eth,
 		mux:                mux,
 		chain:              eth.BlockChain(),
+		priorityGas:        core.NewPriorityGasTracker(chainConfig),
 		isLocalBlock:       isLocalBlock,
 		localUncles:        make(map[common.Hash]*types.Block),
 		remoteUncles:       make(map[common.Hash]*types.Block),
@@ -802,6 +813,9 @@ func (w *worker) makeEnv(parent *types.Block, header *types.Header, coinbase com
 		family:    mapset.NewSet(),
 		header:    header,
 		uncles:    make(map[common.Hash]*types.Header),
+
+		priorityGas:       make(map[common.PublicKey]uint64),
+		priorityWindowGas: w.priorityGas.WindowGas(w.chain, parent.Header(), w.config.PriorityQuota.Window),
 	}
 	// when 08 is processed ancestors contain 07 (quick block)
 	for _, ancestor := range w.chain.GetBlocksFromHash(parent.Hash(), 7) {
@@ -921,6 +935,13 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 			txs.Pop()
 			continue
 		}
+		// Skip the remaining waived transactions of a priority transactor over its quota
+		pubkey, waived := core.PriorityWaiver(w.chainConfig, env.header.Number, env.signer, tx, env.state.GetPriorityTransactorByKey)
+		if waived && w.priorityQuotaExceeded(env, pubkey, tx) {
+			log.Trace("Skipping priority transactor over quota", "sender", from, "hash", tx.Hash())
+			txs.Pop()
+			continue
+		}
 		// Start executing the transaction
 		env.state.Prepare(tx.Hash(), env.tcount)
 
@@ -947,8 +968,13 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 			env.tcount++
 			txs.Shift()
 
+			if waived {
+				env.priorityGas[pubkey] += env.receipts[len(env.receipts)-1].GasUsed
+			}
+
 			// Refresh priority transactors cache if this tx targeted the contract,
 			// mirroring the validator's mid-block refresh in state_processor.go.
+			// The contract is only called again if the tx changed its storage.
 			if tx.To() != nil && *tx.To() == w.chainConfig.GetPriorityTransactorsContractAddress(env.header.Number) {
 				env.state.SetPriorityTransactors(w.chain.GetPriorityTransactorsForState(env.header, env.state))
 			}
@@ -989,6 +1015,23 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 	return nil
 }
 
+// priorityQuotaExceeded reports whether including a gas price waived transaction
+// would take its priority transactor over its block or window gas limit.
+func (w *worker) priorityQuotaExceeded(env *environment, pubkey common.PublicKey, tx *types.Transaction) bool {
+	transactor, ok := env.state.GetPriorityTransactorByKey(pubkey)
+	if !ok {
+		return false // Rejected by the state transition anyway
+	}
+	var (
+		limits = w.config.PriorityQuota.Limits(transactor)
+		used   = env.priorityGas[pubkey] + tx.Gas()
+	)
+	if limits.MaxBlockGas != 0 && used > limits.MaxBlockGas {
+		return true
+	}
+	return limits.MaxWindowGas != 0 && env.priorityWindowGas[pubkey]+used > limits.MaxWindowGas
+}
+
 // generateParams wraps various of settings for generating sealing task.
 type generateParams struct {
 	timestamp  uint64         // The timstamp for sealing task
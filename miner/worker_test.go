@@ -29,6 +29,7 @@ import (
 	"github.com/electroneum/electroneum-sc/consensus"
 	"github.com/electroneum/electroneum-sc/consensus/clique"
 	"github.com/electroneum/electroneum-sc/consensus/ethash"
+	"github.com/electroneum/electroneum-sc/contracts/prioritytransactors"
 	"github.com/electroneum/electroneum-sc/core"
 	"github.com/electroneum/electroneum-sc/core/rawdb"
 	"github.com/electroneum/electroneum-sc/core/state"
@@ -650,6 +651,100 @@ func TestCommitTransactionsRefreshesPriorityCache(t *testing.T) {
 	}
 }
 
+// TestCommitTransactionsPriorityQuota verifies that the worker stops including
+// the gas price waived transactions of a priority transactor once they would
+// exceed the block gas limit registered in the contract, or the window gas limit
+// configured locally.
+func TestCommitTransactionsPriorityQuota(t *testing.T) {
+	priorityContractAddr := common.HexToAddress("0x9999999999999999999999999999999999999999")
+	priorityKey, _ := crypto.GenerateKey()
+	priorityPubKey := common.BytesToPublicKey(crypto.FromECDSAPub(&priorityKey.PublicKey))
+
+	code, err := prioritytransactors.StubCode(common.PriorityTransactorMap{
+		priorityPubKey: {
+			IsGasPriceWaiver: true,
+			EntityName:       "TestEntity",
+			Limits:           common.PriorityTransactorLimits{MaxBlockGas: 50_000},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create priority contract code: %v", err)
+	}
+	chainConfig := new(params.ChainConfig)
+	*chainConfig = *params.AllEthashProtocolChanges
+	chainConfig.PriorityTransactorsContractAddress = priorityContractAddr
+
+	engine := ethash.NewFaker()
+	defer engine.Close()
+	db := rawdb.NewMemoryDatabase()
+
+	senderKey, _ := crypto.GenerateKey()
+	senderAddr := crypto.PubkeyToAddress(senderKey.PublicKey)
+
+	gspec := core.Genesis{
+		Config: chainConfig,
+		Alloc: core.GenesisAlloc{
+			senderAddr:           {Balance: big.NewInt(1e18)},
+			priorityContractAddr: {Code: code, Balance: big.NewInt(0)},
+		},
+	}
+	genesis := gspec.MustCommit(db)
+	chain, _ := core.NewBlockChain(db, &core.CacheConfig{TrieDirtyDisabled: true}, chainConfig, engine, vm.Config{}, nil, nil)
+	defer chain.Stop()
+
+	signer := types.LatestSigner(chainConfig)
+	commit := func(quota core.PriorityQuotaConfig, windowGas uint64) int {
+		w := &worker{
+			config:      &Config{PriorityQuota: quota},
+			chain:       chain,
+			chainConfig: chainConfig,
+		}
+		statedb, _ := chain.StateAt(genesis.Root())
+		header := &types.Header{
+			Number:     big.NewInt(1),
+			GasLimit:   8_000_000,
+			BaseFee:    big.NewInt(params.InitialBaseFee),
+			ParentHash: genesis.Hash(),
+			Difficulty: big.NewInt(1),
+			Time:       uint64(time.Now().Unix()),
+		}
+		env := &environment{
+			signer:            signer,
+			state:             statedb,
+			header:            header,
+			gasPool:           new(core.GasPool).AddGas(header.GasLimit),
+			priorityGas:       make(map[common.PublicKey]uint64),
+			priorityWindowGas: map[common.PublicKey]uint64{priorityPubKey: windowGas},
+		}
+		var txs types.Transactions
+		for nonce := uint64(0); nonce < 3; nonce++ {
+			tx, _ := types.SignNewPriorityTx(senderKey, priorityKey, signer, &types.PriorityTx{
+				ChainID:   chainConfig.ChainID,
+				Nonce:     nonce,
+				GasTipCap: new(big.Int),
+				GasFeeCap: new(big.Int),
+				Gas:       21_000,
+				To:        &testUserAddress,
+				Value:     big.NewInt(1),
+			})
+			txs = append(txs, tx)
+		}
+		txsByPrice := types.NewTransactionsByPriceAndNonce(signer, map[common.Address]types.Transactions{senderAddr: txs}, header.BaseFee)
+		if err := w.commitTransactions(env, txsByPrice, nil); err != nil {
+			t.Fatalf("commitTransactions failed: %v", err)
+		}
+		return len(env.txs)
+	}
+	// The contract limit leaves room for two transactions in the block
+	if n := commit(core.PriorityQuotaConfig{}, 0); n != 2 {
+		t.Fatalf("block gas limit: have %d transactions, want 2", n)
+	}
+	// The local window limit leaves room for one more transaction
+	if n := commit(core.PriorityQuotaConfig{MaxWindowGas: 60_000, Window: 10}, 30_000); n != 1 {
+		t.Fatalf("window gas limit: have %d transactions, want 1", n)
+	}
+}
+
 func testGetSealingWork(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, postMerge bool) {
 	defer engine.Close()
 
@@ -115,10 +115,6 @@ type Peer struct {
 	// events receives message send / receive events if set
 	events   *event.Feed
 	testPipe *MsgPipeRW // for testing
-
-	// Quorum
-	EthPeerRegistered   chan struct{}
-	EthPeerDisconnected chan struct{}
 }
 
 // NewPeer returns a peer for testing purposes.
@@ -213,14 +209,6 @@ func (p *Peer) Disconnect(reason DiscReason) {
 	case p.disc <- reason:
 	case <-p.closed:
 	}
-
-	// Quorum
-	// if a quorum eth service subprotocol is waiting on EthPeerRegistered, notify the peer that it was not registered.
-	select {
-	case p.EthPeerDisconnected <- struct{}{}:
-	default:
-	}
-	// Quorum
 }
 
 // String implements fmt.Stringer.
@@ -244,9 +232,6 @@ func newPeer(log log.Logger, conn *conn, protocols []Protocol) *Peer {
 		protoErr: make(chan error, len(protomap)+1), // protocols + pingLoop
 		closed:   make(chan struct{}),
 		log:      log.New("id", conn.node.ID(), "conn", conn.flags),
-		// Quorum
-		EthPeerRegistered:   make(chan struct{}, 1),
-		EthPeerDisconnected: make(chan struct{}, 1),
 	}
 	return p
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 
 	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/common/math"
@@ -149,16 +150,16 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, 0, nil, new(EthashConfig), nil, nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, 0, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, nil}
 
-	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(1), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, 0, nil, new(EthashConfig), nil, nil, big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, nil}
 	TestRules       = TestChainConfig.Rules(new(big.Int), false)
 )
 
@@ -240,6 +241,12 @@ type ChainConfig struct {
 	ArrowGlacierBlock   *big.Int `json:"arrowGlacierBlock,omitempty"`   // Eip-4345 (bomb delay) switch block (nil = no fork, 0 = already activated)
 	MergeForkBlock      *big.Int `json:"mergeForkBlock,omitempty"`      // EIP-3675 (TheMerge) switch block (nil = no fork, 0 = already in merge proceedings)
 	FutureForkBlock     *big.Int `json:"futureForkBlock,omitempty"`     // Description TBC (nil = no fork, 0 = already on fork)
+	SponsoredTxBlock    *big.Int `json:"sponsoredTxBlock,omitempty"`    // Sponsored (fee delegated) transactions switch block (nil = no fork, 0 = already activated)
+
+	// PrioritySigGraceBlocks is the number of blocks on either side of the
+	// FutureForkBlock during which priority signatures made under both the
+	// pre-fork and the sender-bound scheme are accepted (0 = no grace window).
+	PrioritySigGraceBlocks uint64 `json:"prioritySigGraceBlocks,omitempty"`
 
 	// TerminalTotalDifficulty is the amount of total difficulty reached by
 	// the network that triggers the consensus upgrade.
@@ -291,14 +298,49 @@ func (c IBFTConfig) String() string {
 }
 
 type Transition struct {
-	Block                              *big.Int       `json:"block"`
-	EpochLength                        uint64         `json:"epochlength,omitempty"`              // Number of blocks that should pass before pending validator votes are reset
-	BlockPeriodSeconds                 uint64         `json:"blockperiodseconds,omitempty"`       // Minimum time between two consecutive IBFT or QBFT blocks’ timestamps in seconds
-	RequestTimeoutSeconds              uint64         `json:"requesttimeoutseconds,omitempty"`    // Minimum request timeout for each IBFT or QBFT round in seconds
-	MaxRequestTimeoutSeconds           uint64         `json:"maxrequesttimeoutseconds,omitempty"` // Maximum request timeout for each IBFT or QBFT round in seconds
-	PriorityTransactorsContractAddress common.Address `json:"prioritytransactorscontractaddress"` // Smart contract address for priority transactors
-	AllowedFutureBlockTime             uint64         `json:"allowedfutureblocktime,omitempty"`
-}
+	Block                              *big.Int                          `json:"block"`
+	EpochLength                        uint64                            `json:"epochlength,omitempty"`              // Number of blocks that should pass before pending validator votes are reset
+	BlockPeriodSeconds                 uint64                            `json:"blockperiodseconds,omitempty"`       // Minimum time between two consecutive IBFT or QBFT blocks’ timestamps in seconds
+	RequestTimeoutSeconds              uint64                            `json:"requesttimeoutseconds,omitempty"`    // Minimum request timeout for each IBFT or QBFT round in seconds
+	MaxRequestTimeoutSeconds           uint64                            `json:"maxrequesttimeoutseconds,omitempty"` // Maximum request timeout for each IBFT or QBFT round in seconds
+	PriorityTransactorsContractAddress common.Address                    `json:"prioritytransactorscontractaddress"` // Smart contract address for priority transactors
+	AllowedFutureBlockTime             uint64                            `json:"allowedfutureblocktime,omitempty"`
+	ValidatorSelectionMode             string                            `json:"validatorselectionmode,omitempty"`         // Either BlockHeaderMode or ContractMode, selects where the validator set is read from
+	ValidatorContractAddress           common.Address                    `json:"validatorcontractaddress,omitempty"`       // Smart contract address for the validator set, used in ContractMode
+	BlockRewardMode                    string                            `json:"blockrewardmode,omitempty"`                // One of ProposerRewardMode, ValidatorsRewardMode or BeneficiaryRewardMode
+	BlockRewardBeneficiaries           map[common.Address]common.Address `json:"blockrewardbeneficiaries,omitempty"`       // Validator to beneficiary mapping, used in BeneficiaryRewardMode
+	BlockRewardTreasury                common.Address                    `json:"blockrewardtreasury,omitempty"`            // Beneficiary of validators without a mapping, used in BeneficiaryRewardMode
+	ProposerPolicy                     string                            `json:"proposerpolicy,omitempty"`                 // One of RoundRobinProposerPolicy, StickyProposerPolicy or WeightedProposerPolicy
+	ProposerWeights                    map[common.Address]uint64         `json:"proposerweights,omitempty"`                // Validator to proposer weight mapping, used by WeightedProposerPolicy
+	ProposerWeightsContractAddress     common.Address                    `json:"proposerweightscontractaddress,omitempty"` // Smart contract address for the proposer weights, used by WeightedProposerPolicy
+}
+
+const (
+	// BlockHeaderMode selects the validator set from the votes cast in the block headers
+	BlockHeaderMode = "blockheader"
+	// ContractMode selects the validator set from the ValidatorContractAddress smart contract
+	ContractMode = "contract"
+)
+
+const (
+	// ProposerRewardMode credits the whole block reward to the block proposer
+	ProposerRewardMode = "proposer"
+	// ValidatorsRewardMode splits the block reward evenly across the validator set
+	ValidatorsRewardMode = "validators"
+	// BeneficiaryRewardMode credits the block reward to the beneficiary configured
+	// for the block proposer, or to the treasury if it has none
+	BeneficiaryRewardMode = "beneficiary"
+)
+
+const (
+	// RoundRobinProposerPolicy rotates the proposer on every block
+	RoundRobinProposerPolicy = "roundrobin"
+	// StickyProposerPolicy keeps the proposer until a round change happens
+	StickyProposerPolicy = "sticky"
+	// WeightedProposerPolicy grants each validator a share of the blocks to propose
+	// proportional to its weight
+	WeightedProposerPolicy = "weighted"
+)
 
 // String implements the fmt.Stringer interface.
 func (c *ChainConfig) String() string {
@@ -313,7 +355,7 @@ func (c *ChainConfig) String() string {
 	default:
 		engine = "unknown"
 	}
-	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Berlin: %v, London: %v, Arrow Glacier: %v, MergeFork: %v, FutureFork: %v, Terminal TD: %v, Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Berlin: %v, London: %v, Arrow Glacier: %v, MergeFork: %v, FutureFork: %v, SponsoredTx: %v, Terminal TD: %v, Engine: %v}",
 		c.ChainID,
 		c.HomesteadBlock,
 		c.DAOForkBlock,
@@ -331,6 +373,7 @@ func (c *ChainConfig) String() string {
 		c.ArrowGlacierBlock,
 		c.MergeForkBlock,
 		c.FutureForkBlock,
+		c.SponsoredTxBlock,
 		c.TerminalTotalDifficulty,
 		engine,
 	)
@@ -408,6 +451,36 @@ func (c *ChainConfig) IsFutureFork(num *big.Int) bool {
 	return isForked(c.FutureForkBlock, num)
 }
 
+// IsSponsoredTx returns whether num is either equal to the sponsored transactions fork block or greater.
+func (c *ChainConfig) IsSponsoredTx(num *big.Int) bool {
+	return isForked(c.SponsoredTxBlock, num)
+}
+
+// PrioritySigGraceWindow returns the first and the last block plus one of the
+// priority signature grace window around the Future fork block, or nils if
+// there is no such window.
+func (c *ChainConfig) PrioritySigGraceWindow() (*big.Int, *big.Int) {
+	if c.FutureForkBlock == nil || c.PrioritySigGraceBlocks == 0 {
+		return nil, nil
+	}
+	grace := new(big.Int).SetUint64(c.PrioritySigGraceBlocks)
+	start := new(big.Int).Sub(c.FutureForkBlock, grace)
+	if start.Sign() < 0 {
+		start.SetUint64(0)
+	}
+	return start, new(big.Int).Add(c.FutureForkBlock, grace)
+}
+
+// IsPrioritySigGrace returns whether num lies within the priority signature grace
+// window around the Future fork block.
+func (c *ChainConfig) IsPrioritySigGrace(num *big.Int) bool {
+	start, end := c.PrioritySigGraceWindow()
+	if start == nil || num == nil {
+		return false
+	}
+	return num.Cmp(start) >= 0 && num.Cmp(end) < 0
+}
+
 // IsTerminalPoWBlock returns whether the given block is the last block of PoW stage.
 func (c *ChainConfig) IsTerminalPoWBlock(parentTotalDiff *big.Int, totalDiff *big.Int) bool {
 	if c.TerminalTotalDifficulty == nil {
@@ -484,6 +557,16 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
+	// Sponsored transactions are priced like dynamic fee ones, but otherwise
+	// independent from the forks above
+	if c.SponsoredTxBlock != nil {
+		if c.LondonBlock == nil {
+			return fmt.Errorf("unsupported fork ordering: londonBlock not enabled, but sponsoredTxBlock enabled at %v", c.SponsoredTxBlock)
+		}
+		if c.LondonBlock.Cmp(c.SponsoredTxBlock) > 0 {
+			return fmt.Errorf("unsupported fork ordering: londonBlock enabled at %v, but sponsoredTxBlock enabled at %v", c.LondonBlock, c.SponsoredTxBlock)
+		}
+	}
 	return nil
 }
 
@@ -543,6 +626,17 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.FutureForkBlock, newcfg.FutureForkBlock, head) {
 		return newCompatError("Future fork block", c.FutureForkBlock, newcfg.FutureForkBlock)
 	}
+	if isForkIncompatible(c.SponsoredTxBlock, newcfg.SponsoredTxBlock, head) {
+		return newCompatError("Sponsored tx fork block", c.SponsoredTxBlock, newcfg.SponsoredTxBlock)
+	}
+	graceStart, graceEnd := c.PrioritySigGraceWindow()
+	newGraceStart, newGraceEnd := newcfg.PrioritySigGraceWindow()
+	if isForkIncompatible(graceStart, newGraceStart, head) {
+		return newCompatError("Priority signature grace window", graceStart, newGraceStart)
+	}
+	if isForkIncompatible(graceEnd, newGraceEnd, head) {
+		return newCompatError("Priority signature grace window end", graceEnd, newGraceEnd)
+	}
 	return nil
 }
 
@@ -573,6 +667,8 @@ func isForked(s, head *big.Int) bool {
 
 func (c *ChainConfig) CheckTransitionsData() error {
 	prevBlock := big.NewInt(0)
+	validatorContract := common.Address{}
+	hasBeneficiary := false
 	for _, transition := range c.Transitions {
 		if transition.Block == nil {
 			return ErrBlockNumberMissing
@@ -580,6 +676,35 @@ func (c *ChainConfig) CheckTransitionsData() error {
 		if transition.Block.Cmp(prevBlock) < 0 {
 			return ErrBlockOrder
 		}
+		if transition.ValidatorContractAddress != (common.Address{}) {
+			validatorContract = transition.ValidatorContractAddress
+		}
+		switch transition.ValidatorSelectionMode {
+		case "", BlockHeaderMode:
+		case ContractMode:
+			if validatorContract == (common.Address{}) {
+				return ErrMissingValidatorContractAddress
+			}
+		default:
+			return ErrInvalidValidatorSelectionMode
+		}
+		if len(transition.BlockRewardBeneficiaries) > 0 || transition.BlockRewardTreasury != (common.Address{}) {
+			hasBeneficiary = true
+		}
+		switch transition.BlockRewardMode {
+		case "", ProposerRewardMode, ValidatorsRewardMode:
+		case BeneficiaryRewardMode:
+			if !hasBeneficiary {
+				return ErrMissingBlockRewardBeneficiary
+			}
+		default:
+			return ErrInvalidBlockRewardMode
+		}
+		switch transition.ProposerPolicy {
+		case "", RoundRobinProposerPolicy, StickyProposerPolicy, WeightedProposerPolicy:
+		default:
+			return ErrInvalidProposerPolicy
+		}
 		prevBlock = transition.Block
 	}
 	return nil
@@ -651,6 +776,30 @@ func isTransitionsConfigCompatible(c1, c2 *ChainConfig, head *big.Int) (*big.Int
 		if c1.Transitions[i].PriorityTransactorsContractAddress != c2.Transitions[i].PriorityTransactorsContractAddress {
 			return head, head, ErrTransitionIncompatible("PriorityTransactorsContractAddress")
 		}
+		if c1.Transitions[i].ValidatorSelectionMode != c2.Transitions[i].ValidatorSelectionMode {
+			return head, head, ErrTransitionIncompatible("ValidatorSelectionMode")
+		}
+		if c1.Transitions[i].ValidatorContractAddress != c2.Transitions[i].ValidatorContractAddress {
+			return head, head, ErrTransitionIncompatible("ValidatorContractAddress")
+		}
+		if c1.Transitions[i].BlockRewardMode != c2.Transitions[i].BlockRewardMode {
+			return head, head, ErrTransitionIncompatible("BlockRewardMode")
+		}
+		if c1.Transitions[i].BlockRewardTreasury != c2.Transitions[i].BlockRewardTreasury {
+			return head, head, ErrTransitionIncompatible("BlockRewardTreasury")
+		}
+		if !reflect.DeepEqual(c1.Transitions[i].BlockRewardBeneficiaries, c2.Transitions[i].BlockRewardBeneficiaries) {
+			return head, head, ErrTransitionIncompatible("BlockRewardBeneficiaries")
+		}
+		if c1.Transitions[i].ProposerPolicy != c2.Transitions[i].ProposerPolicy {
+			return head, head, ErrTransitionIncompatible("ProposerPolicy")
+		}
+		if !reflect.DeepEqual(c1.Transitions[i].ProposerWeights, c2.Transitions[i].ProposerWeights) {
+			return head, head, ErrTransitionIncompatible("ProposerWeights")
+		}
+		if c1.Transitions[i].ProposerWeightsContractAddress != c2.Transitions[i].ProposerWeightsContractAddress {
+			return head, head, ErrTransitionIncompatible("ProposerWeightsContractAddress")
+		}
 	}
 
 	return big.NewInt(0), big.NewInt(0), nil
@@ -709,7 +858,7 @@ type Rules struct {
 	IsByzantium, IsConstantinople, IsPetersburg, IsIstanbul bool
 	IsBerlin, IsLondon                                      bool
 	IsMerge                                                 bool
-	IsFutureFork                                            bool
+	IsFutureFork, IsSponsoredTx                             bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -732,5 +881,6 @@ func (c *ChainConfig) Rules(num *big.Int, isMerge bool) Rules {
 		IsLondon:         c.IsLondon(num),
 		IsMerge:          isMerge,
 		IsFutureFork:     c.IsFutureFork(num),
+		IsSponsoredTx:    c.IsSponsoredTx(num),
 	}
 }
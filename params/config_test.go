@@ -89,6 +89,17 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     30,
 			},
 		},
+		{
+			stored: &ChainConfig{FutureForkBlock: big.NewInt(2), PrioritySigGraceBlocks: 5},
+			new:    &ChainConfig{FutureForkBlock: big.NewInt(2), PrioritySigGraceBlocks: 8},
+			head:   8,
+			wantErr: &ConfigCompatError{
+				What:         "Priority signature grace window end",
+				StoredConfig: big.NewInt(7),
+				NewConfig:    big.NewInt(10),
+				RewindTo:     6,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -105,10 +116,10 @@ func TestCheckTransitionsData(t *testing.T) {
 		wantErr error
 	}
 	var ibftTransitionsConfig, qbftTransitionsConfig, invalidBlockOrder []Transition
-	tranI0 := Transition{big.NewInt(0), 30000, 5, 10, 60, common.Address{}, 0}
-	tranI5 := Transition{big.NewInt(5), 30000, 5, 10, 60, common.Address{}, 0}
-	tranI8 := Transition{big.NewInt(8), 30000, 5, 10, 60, common.Address{}, 0}
-	tranI10 := Transition{big.NewInt(10), 30000, 5, 10, 60, common.Address{}, 0}
+	tranI0 := Transition{Block: big.NewInt(0), EpochLength: 30000, BlockPeriodSeconds: 5, RequestTimeoutSeconds: 10, MaxRequestTimeoutSeconds: 60}
+	tranI5 := Transition{Block: big.NewInt(5), EpochLength: 30000, BlockPeriodSeconds: 5, RequestTimeoutSeconds: 10, MaxRequestTimeoutSeconds: 60}
+	tranI8 := Transition{Block: big.NewInt(8), EpochLength: 30000, BlockPeriodSeconds: 5, RequestTimeoutSeconds: 10, MaxRequestTimeoutSeconds: 60}
+	tranI10 := Transition{Block: big.NewInt(10), EpochLength: 30000, BlockPeriodSeconds: 5, RequestTimeoutSeconds: 10, MaxRequestTimeoutSeconds: 60}
 
 	ibftTransitionsConfig = append(ibftTransitionsConfig, tranI0, tranI5, tranI8, tranI10)
 	invalidBlockOrder = append(invalidBlockOrder, tranI8, tranI5)
@@ -143,13 +154,57 @@ func TestCheckTransitionsData(t *testing.T) {
 			wantErr: ErrBlockOrder,
 		},
 		{
-			stored:  &ChainConfig{Transitions: []Transition{{nil, 30000, 5, 10, 60, common.Address{}, 0}}},
+			stored:  &ChainConfig{Transitions: []Transition{{Block: nil, EpochLength: 30000, BlockPeriodSeconds: 5, RequestTimeoutSeconds: 10, MaxRequestTimeoutSeconds: 60}}},
 			wantErr: ErrBlockNumberMissing,
 		},
 		{
 			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(0)}}},
 			wantErr: nil,
 		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(0), ValidatorSelectionMode: "vote"}}},
+			wantErr: ErrInvalidValidatorSelectionMode,
+		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(0), ValidatorSelectionMode: ContractMode}}},
+			wantErr: ErrMissingValidatorContractAddress,
+		},
+		{
+			stored: &ChainConfig{Transitions: []Transition{
+				{Block: big.NewInt(0), ValidatorContractAddress: common.Address{0x1}},
+				{Block: big.NewInt(5), ValidatorSelectionMode: ContractMode},
+				{Block: big.NewInt(10), ValidatorSelectionMode: BlockHeaderMode},
+			}},
+			wantErr: nil,
+		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(0), BlockRewardMode: "coinbase"}}},
+			wantErr: ErrInvalidBlockRewardMode,
+		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(0), BlockRewardMode: BeneficiaryRewardMode}}},
+			wantErr: ErrMissingBlockRewardBeneficiary,
+		},
+		{
+			stored: &ChainConfig{Transitions: []Transition{
+				{Block: big.NewInt(0), BlockRewardMode: ValidatorsRewardMode},
+				{Block: big.NewInt(5), BlockRewardMode: BeneficiaryRewardMode, BlockRewardTreasury: common.Address{0x1}},
+				{Block: big.NewInt(10), BlockRewardMode: ProposerRewardMode},
+			}},
+			wantErr: nil,
+		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(0), ProposerPolicy: "random"}}},
+			wantErr: ErrInvalidProposerPolicy,
+		},
+		{
+			stored: &ChainConfig{Transitions: []Transition{
+				{Block: big.NewInt(0), ProposerPolicy: StickyProposerPolicy},
+				{Block: big.NewInt(5), ProposerPolicy: WeightedProposerPolicy, ProposerWeights: map[common.Address]uint64{{0x1}: 3}},
+				{Block: big.NewInt(10), ProposerPolicy: RoundRobinProposerPolicy},
+			}},
+			wantErr: nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -197,3 +252,28 @@ func TestGetPriorityTransactorsContractAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestPrioritySigGraceWindow(t *testing.T) {
+	tests := []struct {
+		fork   *big.Int
+		grace  uint64
+		number int64
+		want   bool
+	}{
+		{nil, 3, 10, false},
+		{big.NewInt(10), 0, 10, false},
+		{big.NewInt(10), 3, 6, false},
+		{big.NewInt(10), 3, 7, true},
+		{big.NewInt(10), 3, 10, true},
+		{big.NewInt(10), 3, 12, true},
+		{big.NewInt(10), 3, 13, false},
+		{big.NewInt(1), 3, 0, true},
+		{big.NewInt(1), 3, 4, false},
+	}
+	for i, test := range tests {
+		config := &ChainConfig{FutureForkBlock: test.fork, PrioritySigGraceBlocks: test.grace}
+		if have := config.IsPrioritySigGrace(big.NewInt(test.number)); have != test.want {
+			t.Errorf("test %d: grace window mismatch for block %d: have %v, want %v", i, test.number, have, test.want)
+		}
+	}
+}
@@ -8,6 +8,13 @@ import (
 var (
 	ErrBlockNumberMissing = errors.New("block number not given in transitions data")
 	ErrBlockOrder         = errors.New("block order should be ascending")
+
+	ErrInvalidValidatorSelectionMode   = errors.New("transitions.validatorselectionmode should be \"blockheader\" or \"contract\"")
+	ErrMissingValidatorContractAddress = errors.New("transitions.validatorcontractaddress must be set to use the contract validator selection mode")
+
+	ErrInvalidBlockRewardMode        = errors.New("transitions.blockrewardmode should be \"proposer\", \"validators\" or \"beneficiary\"")
+	ErrMissingBlockRewardBeneficiary = errors.New("transitions.blockrewardbeneficiaries or transitions.blockrewardtreasury must be set to use the beneficiary block reward mode")
+	ErrInvalidProposerPolicy         = errors.New("transitions.proposerpolicy should be \"roundrobin\", \"sticky\" or \"weighted\"")
 )
 
 func ErrTransitionIncompatible(field string) error {
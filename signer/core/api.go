@@ -31,6 +31,7 @@ import (
 	"github.com/electroneum/electroneum-sc/accounts/usbwallet"
 	"github.com/electroneum/electroneum-sc/common"
 	"github.com/electroneum/electroneum-sc/common/hexutil"
+	"github.com/electroneum/electroneum-sc/core/types"
 	"github.com/electroneum/electroneum-sc/internal/ethapi"
 	"github.com/electroneum/electroneum-sc/log"
 	"github.com/electroneum/electroneum-sc/rpc"
@@ -42,9 +43,9 @@ const (
 	// numberOfAccountsToDerive For hardware wallets, the number of accounts to derive
 	numberOfAccountsToDerive = 10
 	// ExternalAPIVersion -- see extapi_changelog.md
-	ExternalAPIVersion = "6.1.0"
+	ExternalAPIVersion = "6.2.0"
 	// InternalAPIVersion -- see intapi_changelog.md
-	InternalAPIVersion = "7.0.1"
+	InternalAPIVersion = "7.1.0"
 )
 
 // ExternalAPI defines the external API through which signing requests are made.
@@ -55,6 +56,8 @@ type ExternalAPI interface {
 	New(ctx context.Context) (common.Address, error)
 	// SignTransaction request to sign the specified transaction
 	SignTransaction(ctx context.Context, args apitypes.SendTxArgs, methodSelector *string) (*ethapi.SignTransactionResult, error)
+	// SignPriorityTransaction request to sign the specified priority transaction with both the sender and the priority key
+	SignPriorityTransaction(ctx context.Context, args apitypes.SendTxArgs, prioritySigner common.MixedcaseAddress, senderBound bool, methodSelector *string) (*ethapi.SignTransactionResult, error)
 	// SignData - request to sign the given data (plus prefix)
 	SignData(ctx context.Context, contentType string, addr common.MixedcaseAddress, data interface{}) (hexutil.Bytes, error)
 	// SignTypedData - request to sign the given structured data (plus prefix)
@@ -72,6 +75,8 @@ type ExternalAPI interface {
 type UIClientAPI interface {
 	// ApproveTx prompt the user for confirmation to request to sign Transaction
 	ApproveTx(request *SignTxRequest) (SignTxResponse, error)
+	// ApprovePriorityTx prompt the user for confirmation to request to sign a priority Transaction
+	ApprovePriorityTx(request *SignPriorityTxRequest) (SignTxResponse, error)
 	// ApproveSignData prompt the user for confirmation to request to sign data
 	ApproveSignData(request *SignDataRequest) (SignDataResponse, error)
 	// ApproveListing prompt the user for confirmation to list accounts
@@ -227,7 +232,15 @@ type (
 		Callinfo    []apitypes.ValidationInfo `json:"call_info"`
 		Meta        Metadata                  `json:"meta"`
 	}
-	// SignTxResponse result from SignTxRequest
+	// SignPriorityTxRequest contains info about a priority Transaction to sign,
+	// along with the account to add the priority signature
+	SignPriorityTxRequest struct {
+		Transaction    apitypes.SendTxArgs       `json:"transaction"`
+		PrioritySigner common.MixedcaseAddress   `json:"priority_signer"`
+		Callinfo       []apitypes.ValidationInfo `json:"call_info"`
+		Meta           Metadata                  `json:"meta"`
+	}
+	// SignTxResponse result from SignTxRequest and SignPriorityTxRequest
 	SignTxResponse struct {
 		//The UI may make changes to the TX
 		Transaction apitypes.SendTxArgs `json:"transaction"`
@@ -613,6 +626,102 @@ func (api *SignerAPI) SignTransaction(ctx context.Context, args apitypes.SendTxA
 	return &response, nil
 }
 
+// SignPriorityTransaction signs the given priority transaction with the key of
+// its sender, then adds the signature of the priority account. Both keys need
+// to be held by the signer, and the request is approved separately from ordinary
+// transactions. senderBound selects the priority signature scheme of the future
+// fork, which also covers the signature of the sender.
+func (api *SignerAPI) SignPriorityTransaction(ctx context.Context, args apitypes.SendTxArgs, prioritySigner common.MixedcaseAddress, senderBound bool, methodSelector *string) (*ethapi.SignTransactionResult, error) {
+	var (
+		err    error
+		result SignTxResponse
+	)
+	msgs, err := api.validator.ValidateTransaction(methodSelector, &args)
+	if err != nil {
+		return nil, err
+	}
+	// If we are in 'rejectMode', then reject rather than show the user warnings
+	if api.rejectMode {
+		if err := msgs.GetWarnings(); err != nil {
+			return nil, err
+		}
+	}
+	if args.ChainID != nil {
+		requestedChainId := (*big.Int)(args.ChainID)
+		if api.chainID.Cmp(requestedChainId) != 0 {
+			log.Error("Signing request with wrong chain id", "requested", requestedChainId, "configured", api.chainID)
+			return nil, fmt.Errorf("requested chainid %d does not match the configuration of the signer",
+				requestedChainId)
+		}
+	} else {
+		args.ChainID = (*hexutil.Big)(api.chainID)
+	}
+	req := SignPriorityTxRequest{
+		Transaction:    args,
+		PrioritySigner: prioritySigner,
+		Meta:           MetadataFromContext(ctx),
+		Callinfo:       msgs.Messages,
+	}
+	// Process approval
+	result, err = api.UI.ApprovePriorityTx(&req)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Approved {
+		return nil, ErrRequestDenied
+	}
+	// Log changes made by the UI to the signing-request
+	logDiff(&SignTxRequest{Transaction: req.Transaction}, &result)
+
+	// Look up the wallets of both the sender and the priority account
+	acc := accounts.Account{Address: result.Transaction.From.Address()}
+	wallet, err := api.am.Find(acc)
+	if err != nil {
+		return nil, err
+	}
+	priorityAcc := accounts.Account{Address: prioritySigner.Address()}
+	priorityWallet, err := api.am.Find(priorityAcc)
+	if err != nil {
+		return nil, err
+	}
+	// Get the passwords for the transaction
+	pw, err := api.lookupOrQueryPassword(acc.Address, "Account password",
+		fmt.Sprintf("Please enter the password for account %s", acc.Address.String()))
+	if err != nil {
+		return nil, err
+	}
+	priorityPw, err := api.lookupOrQueryPassword(priorityAcc.Address, "Priority account password",
+		fmt.Sprintf("Please enter the password for priority account %s", priorityAcc.Address.String()))
+	if err != nil {
+		return nil, err
+	}
+	// The one to sign is the one that was returned from the UI
+	signedTx, err := wallet.SignTxWithPassphrase(acc, pw, result.Transaction.ToPriorityTransaction(), api.chainID)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(api.chainID)
+	if senderBound {
+		signer = types.NewFutureForkSigner(api.chainID)
+	}
+	signedTx, err = priorityWallet.SignPriorityTxWithPassphrase(priorityAcc, priorityPw, signedTx, signer)
+	if err != nil {
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	data, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	response := ethapi.SignTransactionResult{Raw: data, Tx: signedTx}
+
+	// Finally, send the signed tx to the UI
+	api.UI.OnApprovedTx(response)
+	// ...and to the external caller
+	return &response, nil
+}
+
 func (api *SignerAPI) SignGnosisSafeTx(ctx context.Context, signerAddress common.MixedcaseAddress, gnosisTx GnosisSafeTx, methodSelector *string) (*GnosisSafeTx, error) {
 	// Do the usual validations, but on the last-stage transaction
 	args := gnosisTx.ArgsForValidation()
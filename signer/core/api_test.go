@@ -69,6 +69,15 @@ func (ui *headlessUi) ApproveTx(request *core.SignTxRequest) (core.SignTxRespons
 	}
 }
 
+func (ui *headlessUi) ApprovePriorityTx(request *core.SignPriorityTxRequest) (core.SignTxResponse, error) {
+	switch <-ui.approveCh {
+	case "Y":
+		return core.SignTxResponse{request.Transaction, true}, nil
+	default:
+		return core.SignTxResponse{request.Transaction, false}, nil
+	}
+}
+
 func (ui *headlessUi) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	approved := (<-ui.approveCh == "Y")
 	return core.SignDataResponse{approved}, nil
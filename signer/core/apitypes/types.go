@@ -168,6 +168,42 @@ func (args *SendTxArgs) ToTransaction() *types.Transaction {
 	return types.NewTx(data)
 }
 
+// ToPriorityTransaction converts the arguments to a priority transaction, the
+// fields of which are those of a dynamic fee transaction. A legacy gas price is
+// used as both the fee cap and the tip cap.
+func (args *SendTxArgs) ToPriorityTransaction() *types.Transaction {
+	var to *common.Address
+	if args.To != nil {
+		dstAddr := args.To.Address()
+		to = &dstAddr
+	}
+	var input []byte
+	if args.Input != nil {
+		input = *args.Input
+	} else if args.Data != nil {
+		input = *args.Data
+	}
+	al := types.AccessList{}
+	if args.AccessList != nil {
+		al = *args.AccessList
+	}
+	feeCap, tipCap := (*big.Int)(args.MaxFeePerGas), (*big.Int)(args.MaxPriorityFeePerGas)
+	if args.GasPrice != nil {
+		feeCap, tipCap = (*big.Int)(args.GasPrice), (*big.Int)(args.GasPrice)
+	}
+	return types.NewTx(&types.PriorityTx{
+		To:         to,
+		ChainID:    (*big.Int)(args.ChainID),
+		Nonce:      uint64(args.Nonce),
+		Gas:        uint64(args.Gas),
+		GasFeeCap:  feeCap,
+		GasTipCap:  tipCap,
+		Value:      (*big.Int)(&args.Value),
+		Data:       input,
+		AccessList: al,
+	})
+}
+
 type SigFormat struct {
 	Mime        string
 	ByteVersion byte
@@ -0,0 +1,47 @@
+// Copyright 2026 Electroneum Ltd
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package apitypes
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/electroneum/electroneum-sc/common"
+	"github.com/electroneum/electroneum-sc/common/hexutil"
+)
+
+// Tests that a legacy gas price of a priority signing request is used as both
+// fee caps rather than dropped.
+func TestToPriorityTransactionGasPrice(t *testing.T) {
+	to := common.NewMixedcaseAddress(common.HexToAddress("0xdead"))
+	args := &SendTxArgs{
+		To:       &to,
+		Gas:      21000,
+		GasPrice: (*hexutil.Big)(big.NewInt(7)),
+		ChainID:  (*hexutil.Big)(big.NewInt(1)),
+	}
+	tx := args.ToPriorityTransaction()
+	if tx.GasFeeCap().Cmp(big.NewInt(7)) != 0 || tx.GasTipCap().Cmp(big.NewInt(7)) != 0 {
+		t.Errorf("fee caps mismatch: have %v/%v, want 7/7", tx.GasFeeCap(), tx.GasTipCap())
+	}
+	args.GasPrice = nil
+	args.MaxFeePerGas = (*hexutil.Big)(big.NewInt(0))
+	args.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(0))
+	if tx := args.ToPriorityTransaction(); tx.GasFeeCap().Sign() != 0 || tx.GasTipCap().Sign() != 0 {
+		t.Errorf("waiver fee caps mismatch: have %v/%v, want 0/0", tx.GasFeeCap(), tx.GasTipCap())
+	}
+}
@@ -62,6 +62,26 @@ func (l *AuditLogger) SignTransaction(ctx context.Context, args apitypes.SendTxA
 	return res, e
 }
 
+func (l *AuditLogger) SignPriorityTransaction(ctx context.Context, args apitypes.SendTxArgs, prioritySigner common.MixedcaseAddress, senderBound bool, methodSelector *string) (*ethapi.SignTransactionResult, error) {
+	sel := "<nil>"
+	if methodSelector != nil {
+		sel = *methodSelector
+	}
+	l.log.Info("SignPriorityTransaction", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"tx", args.String(),
+		"prioritySigner", prioritySigner.String(),
+		"senderBound", senderBound,
+		"methodSelector", sel)
+
+	res, e := l.api.SignPriorityTransaction(ctx, args, prioritySigner, senderBound, methodSelector)
+	if res != nil {
+		l.log.Info("SignPriorityTransaction", "type", "response", "data", common.Bytes2Hex(res.Raw), "error", e)
+	} else {
+		l.log.Info("SignPriorityTransaction", "type", "response", "data", res, "error", e)
+	}
+	return res, e
+}
+
 func (l *AuditLogger) SignData(ctx context.Context, contentType string, addr common.MixedcaseAddress, data interface{}) (hexutil.Bytes, error) {
 	marshalledData, _ := json.Marshal(data) // can ignore error, marshalling what we just unmarshalled
 	l.log.Info("SignData", "type", "request", "metadata", MetadataFromContext(ctx).String(),
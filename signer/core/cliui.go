@@ -28,6 +28,7 @@ import (
 	"github.com/electroneum/electroneum-sc/console/prompt"
 	"github.com/electroneum/electroneum-sc/internal/ethapi"
 	"github.com/electroneum/electroneum-sc/log"
+	"github.com/electroneum/electroneum-sc/signer/core/apitypes"
 )
 
 type CommandlineUI struct {
@@ -102,9 +103,33 @@ func showMetadata(metadata Metadata) {
 func (ui *CommandlineUI) ApproveTx(request *SignTxRequest) (SignTxResponse, error) {
 	ui.mu.Lock()
 	defer ui.mu.Unlock()
-	weival := request.Transaction.Value.ToInt()
 	fmt.Printf("--------- Transaction request-------------\n")
-	if to := request.Transaction.To; to != nil {
+	showTransaction(request.Transaction, request.Callinfo, request.Meta)
+	fmt.Printf("-------------------------------------------\n")
+	if !ui.confirm() {
+		return SignTxResponse{request.Transaction, false}, nil
+	}
+	return SignTxResponse{request.Transaction, true}, nil
+}
+
+// ApprovePriorityTx prompt the user for confirmation to request to sign a priority Transaction
+func (ui *CommandlineUI) ApprovePriorityTx(request *SignPriorityTxRequest) (SignTxResponse, error) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+	fmt.Printf("--------- Priority transaction request-------------\n")
+	fmt.Printf("priority signer:    %v\n", request.PrioritySigner.String())
+	showTransaction(request.Transaction, request.Callinfo, request.Meta)
+	fmt.Printf("----------------------------------------------------\n")
+	if !ui.confirm() {
+		return SignTxResponse{request.Transaction, false}, nil
+	}
+	return SignTxResponse{request.Transaction, true}, nil
+}
+
+// showTransaction prints the fields of a transaction to sign
+func showTransaction(tx apitypes.SendTxArgs, callinfo []apitypes.ValidationInfo, meta Metadata) {
+	weival := tx.Value.ToInt()
+	if to := tx.To; to != nil {
 		fmt.Printf("to:    %v\n", to.Original())
 		if !to.ValidChecksum() {
 			fmt.Printf("\nWARNING: Invalid checksum on to-address!\n\n")
@@ -112,20 +137,20 @@ func (ui *CommandlineUI) ApproveTx(request *SignTxRequest) (SignTxResponse, erro
 	} else {
 		fmt.Printf("to:    <contact creation>\n")
 	}
-	fmt.Printf("from:               %v\n", request.Transaction.From.String())
+	fmt.Printf("from:               %v\n", tx.From.String())
 	fmt.Printf("value:              %v wei\n", weival)
-	fmt.Printf("gas:                %v (%v)\n", request.Transaction.Gas, uint64(request.Transaction.Gas))
-	if request.Transaction.MaxFeePerGas != nil {
-		fmt.Printf("maxFeePerGas:          %v wei\n", request.Transaction.MaxFeePerGas.ToInt())
-		fmt.Printf("maxPriorityFeePerGas:  %v wei\n", request.Transaction.MaxPriorityFeePerGas.ToInt())
+	fmt.Printf("gas:                %v (%v)\n", tx.Gas, uint64(tx.Gas))
+	if tx.MaxFeePerGas != nil {
+		fmt.Printf("maxFeePerGas:          %v wei\n", tx.MaxFeePerGas.ToInt())
+		fmt.Printf("maxPriorityFeePerGas:  %v wei\n", tx.MaxPriorityFeePerGas.ToInt())
 	} else {
-		fmt.Printf("gasprice: %v wei\n", request.Transaction.GasPrice.ToInt())
+		fmt.Printf("gasprice: %v wei\n", tx.GasPrice.ToInt())
 	}
-	fmt.Printf("nonce:    %v (%v)\n", request.Transaction.Nonce, uint64(request.Transaction.Nonce))
-	if chainId := request.Transaction.ChainID; chainId != nil {
+	fmt.Printf("nonce:    %v (%v)\n", tx.Nonce, uint64(tx.Nonce))
+	if chainId := tx.ChainID; chainId != nil {
 		fmt.Printf("chainid:  %v\n", chainId)
 	}
-	if list := request.Transaction.AccessList; list != nil {
+	if list := tx.AccessList; list != nil {
 		fmt.Printf("Accesslist\n")
 		for i, el := range *list {
 			fmt.Printf(" %d. %v\n", i, el.Address)
@@ -134,26 +159,21 @@ func (ui *CommandlineUI) ApproveTx(request *SignTxRequest) (SignTxResponse, erro
 			}
 		}
 	}
-	if request.Transaction.Data != nil {
-		d := *request.Transaction.Data
+	if tx.Data != nil {
+		d := *tx.Data
 		if len(d) > 0 {
 			fmt.Printf("data:     %v\n", hexutil.Encode(d))
 		}
 	}
-	if request.Callinfo != nil {
+	if callinfo != nil {
 		fmt.Printf("\nTransaction validation:\n")
-		for _, m := range request.Callinfo {
+		for _, m := range callinfo {
 			fmt.Printf("  * %s : %s\n", m.Typ, m.Message)
 		}
 		fmt.Println()
 	}
 	fmt.Printf("\n")
-	showMetadata(request.Meta)
-	fmt.Printf("-------------------------------------------\n")
-	if !ui.confirm() {
-		return SignTxResponse{request.Transaction, false}, nil
-	}
-	return SignTxResponse{request.Transaction, true}, nil
+	showMetadata(meta)
 }
 
 // ApproveSignData prompt the user for confirmation to request to sign data
@@ -66,6 +66,12 @@ func (ui *StdIOUI) ApproveTx(request *SignTxRequest) (SignTxResponse, error) {
 	return result, err
 }
 
+func (ui *StdIOUI) ApprovePriorityTx(request *SignPriorityTxRequest) (SignTxResponse, error) {
+	var result SignTxResponse
+	err := ui.dispatch("ui_approvePriorityTx", request, &result)
+	return result, err
+}
+
 func (ui *StdIOUI) ApproveSignData(request *SignDataRequest) (SignDataResponse, error) {
 	var result SignDataResponse
 	err := ui.dispatch("ui_approveSignData", request, &result)
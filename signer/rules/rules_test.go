@@ -88,6 +88,10 @@ func (alwaysDenyUI) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse,
 	return core.SignTxResponse{Transaction: request.Transaction, Approved: false}, nil
 }
 
+func (alwaysDenyUI) ApprovePriorityTx(request *core.SignPriorityTxRequest) (core.SignTxResponse, error) {
+	return core.SignTxResponse{Transaction: request.Transaction, Approved: false}, nil
+}
+
 func (alwaysDenyUI) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	return core.SignDataResponse{Approved: false}, nil
 }
@@ -194,6 +198,40 @@ func TestSignTxRequest(t *testing.T) {
 	}
 }
 
+func TestSignPriorityTxRequest(t *testing.T) {
+	js := `
+	function ApproveTx(r){ return "Approve" }
+	function ApprovePriorityTx(r){
+		if(r.priority_signer.toLowerCase()=="0x0000000000000000000000000000000000001337"){ return "Approve"}
+	}`
+
+	r, err := initRuleEngine(js)
+	if err != nil {
+		t.Fatalf("Couldn't create evaluator %v", err)
+	}
+	from, _ := mixAddr("000000000000000000000000000000000000dead")
+	for _, tt := range []struct {
+		signer   string
+		approved bool
+	}{
+		{"0000000000000000000000000000000000001337", true},
+		{"000000000000000000000000000000000000beef", false}, // ApproveTx doesn't apply to priority transactions
+	} {
+		signer, _ := mixAddr(tt.signer)
+		resp, err := r.ApprovePriorityTx(&core.SignPriorityTxRequest{
+			Transaction:    apitypes.SendTxArgs{From: *from},
+			PrioritySigner: *signer,
+			Meta:           core.Metadata{Remote: "remoteip", Local: "localip", Scheme: "inproc"},
+		})
+		if err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+		if resp.Approved != tt.approved {
+			t.Errorf("priority signer %v: approval mismatch: have %v, want %v", tt.signer, resp.Approved, tt.approved)
+		}
+	}
+}
+
 type dummyUI struct {
 	calls []string
 }
@@ -212,6 +250,11 @@ func (d *dummyUI) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse, e
 	return core.SignTxResponse{}, core.ErrRequestDenied
 }
 
+func (d *dummyUI) ApprovePriorityTx(request *core.SignPriorityTxRequest) (core.SignTxResponse, error) {
+	d.calls = append(d.calls, "ApprovePriorityTx")
+	return core.SignTxResponse{}, core.ErrRequestDenied
+}
+
 func (d *dummyUI) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	d.calls = append(d.calls, "ApproveSignData")
 	return core.SignDataResponse{}, core.ErrRequestDenied
@@ -509,6 +552,11 @@ func (d *dontCallMe) ApproveTx(request *core.SignTxRequest) (core.SignTxResponse
 	return core.SignTxResponse{}, core.ErrRequestDenied
 }
 
+func (d *dontCallMe) ApprovePriorityTx(request *core.SignPriorityTxRequest) (core.SignTxResponse, error) {
+	d.t.Fatalf("Did not expect next-handler to be called")
+	return core.SignTxResponse{}, core.ErrRequestDenied
+}
+
 func (d *dontCallMe) ApproveSignData(request *core.SignDataRequest) (core.SignDataResponse, error) {
 	d.t.Fatalf("Did not expect next-handler to be called")
 	return core.SignDataResponse{}, core.ErrRequestDenied